@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -8,13 +9,15 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema.UpdateFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		client := meta.(*ArmClient).hdinsight.ClustersClient
-		ctx := meta.(*ArmClient).StopContext
+		ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+		defer cancel()
 
 		id, err := azure.ParseAzureResourceID(d.Id())
 		if err != nil {
@@ -55,6 +58,13 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 			}
 		}
 
+		if d.HasChange("monitor") {
+			log.Printf("[DEBUG] Updating the Monitoring for the HDInsight %q Cluster", clusterKind)
+			if err := hdinsightClusterUpdateMonitoring(ctx, meta, resourceGroup, name, d.Get("monitor").([]interface{})); err != nil {
+				return fmt.Errorf("Error updating Monitoring for HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
+			}
+		}
+
 		return readFunc(d, meta)
 	}
 }
@@ -62,7 +72,8 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 func hdinsightClusterDelete(clusterKind string) schema.DeleteFunc {
 	return func(d *schema.ResourceData, meta interface{}) error {
 		client := meta.(*ArmClient).hdinsight.ClustersClient
-		ctx := meta.(*ArmClient).StopContext
+		ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+		defer cancel()
 
 		id, err := azure.ParseAzureResourceID(d.Id())
 		if err != nil {
@@ -85,6 +96,51 @@ func hdinsightClusterDelete(clusterKind string) schema.DeleteFunc {
 	}
 }
 
+// hdinsightClusterUpdateMonitoring enables the Log Analytics monitoring for the cluster when `input` is set
+// and otherwise disables it, since this is managed through a separate API from the Cluster itself
+func hdinsightClusterUpdateMonitoring(ctx context.Context, meta interface{}, resourceGroup, name string, input []interface{}) error {
+	client := meta.(*ArmClient).hdinsight.ExtensionsClient
+
+	if len(input) == 0 {
+		future, err := client.DisableMonitoring(ctx, resourceGroup, name)
+		if err != nil {
+			return fmt.Errorf("disabling monitoring: %+v", err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for monitoring to be disabled: %+v", err)
+		}
+
+		return nil
+	}
+
+	future, err := client.EnableMonitoring(ctx, resourceGroup, name, azure.ExpandHDInsightsMonitor(input))
+	if err != nil {
+		return fmt.Errorf("enabling monitoring: %+v", err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for monitoring to be enabled: %+v", err)
+	}
+
+	return nil
+}
+
+func hdinsightClusterReadMonitoring(ctx context.Context, d *schema.ResourceData, meta interface{}, resourceGroup, name string) error {
+	client := meta.(*ArmClient).hdinsight.ExtensionsClient
+
+	monitor, err := client.GetMonitoringStatus(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Monitoring Status for HDInsight Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := d.Set("monitor", azure.FlattenHDInsightsMonitor(monitor, d.Get("monitor").([]interface{}))); err != nil {
+		return fmt.Errorf("Error flattening `monitor`: %+v", err)
+	}
+
+	return nil
+}
+
 type hdInsightRoleDefinition struct {
 	HeadNodeDef      azure.HDInsightNodeDefinition
 	WorkerNodeDef    azure.HDInsightNodeDefinition
@@ -141,7 +141,7 @@ type ArmClient struct {
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
-func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string, disableCorrelationRequestID bool) (*ArmClient, error) {
+func getArmClient(c *authentication.Config, skipProviderRegistration bool, partnerId string, userAgentSuffix string, disableCorrelationRequestID bool, throttling common.ThrottlingOptions) (*ArmClient, error) {
 	env, err := authentication.DetermineEnvironment(c.Environment)
 	if err != nil {
 		return nil, err
@@ -194,6 +194,7 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		SubscriptionId:              c.SubscriptionID,
 		TenantID:                    c.TenantID,
 		PartnerId:                   partnerId,
+		UserAgentSuffix:             userAgentSuffix,
 		GraphAuthorizer:             graphAuth,
 		GraphEndpoint:               graphEndpoint,
 		KeyVaultAuthorizer:          keyVaultAuth,
@@ -204,6 +205,7 @@ func getArmClient(c *authentication.Config, skipProviderRegistration bool, partn
 		SkipProviderReg:             skipProviderRegistration,
 		DisableCorrelationRequestID: disableCorrelationRequestID,
 		Environment:                 *env,
+		Throttling:                  throttling,
 	}
 
 	client.analysisservices = analysisservices.BuildClient(o)
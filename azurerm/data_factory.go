@@ -1,8 +1,10 @@
 package azurerm
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"sort"
 	"strings"
@@ -137,6 +139,46 @@ func flattenDataFactoryVariables(input map[string]*datafactory.VariableSpecifica
 	return output
 }
 
+func expandDataFactoryPipelineActivities(input string) (*[]datafactory.BasicActivity, error) {
+	// the Activities are polymorphic, so we unmarshal them via a Pipeline to use the SDK's discriminators
+	body := fmt.Sprintf(`{"activities": %s}`, input)
+
+	var pipeline datafactory.Pipeline
+	if err := json.Unmarshal([]byte(body), &pipeline); err != nil {
+		return nil, err
+	}
+
+	return pipeline.Activities, nil
+}
+
+// The Activities are a JSON array rather than an object, so we compare them ignoring any formatting/ordering differences
+func azureRmDataFactoryPipelineActivitiesDiff(_, old, new string, _ *schema.ResourceData) bool {
+	var oldActivities []interface{}
+	if err := json.Unmarshal([]byte(old), &oldActivities); err != nil {
+		return false
+	}
+
+	var newActivities []interface{}
+	if err := json.Unmarshal([]byte(new), &newActivities); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldActivities, newActivities)
+}
+
+func flattenDataFactoryPipelineActivities(input *[]datafactory.BasicActivity) (string, error) {
+	if input == nil || len(*input) == 0 {
+		return "", nil
+	}
+
+	result, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return string(result), nil
+}
+
 // DatasetColumn describes the attributes needed to specify a structure column for a dataset
 type DatasetColumn struct {
 	Name        string `json:"name,omitempty"`
@@ -1,6 +1,10 @@
 package azurerm
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+)
 
 func TestAzureRmDataFactoryLinkedServiceConnectionStringDiff(t *testing.T) {
 	cases := []struct {
@@ -38,3 +42,35 @@ func TestAzureRmDataFactoryLinkedServiceConnectionStringDiff(t *testing.T) {
 		}
 	}
 }
+
+func TestDataFactoryPipelineActivities(t *testing.T) {
+	input := `[{"name": "Append variable1", "type": "AppendVariable", "dependsOn": [], "userProperties": [], "typeProperties": {"variableName": "bob", "value": "something"}}]`
+
+	activities, err := expandDataFactoryPipelineActivities(input)
+	if err != nil {
+		t.Fatalf("Error expanding activities: %+v", err)
+	}
+	if activities == nil || len(*activities) != 1 {
+		t.Fatalf("Expected 1 activity to be expanded")
+	}
+	if _, ok := (*activities)[0].AsAppendVariableActivity(); !ok {
+		t.Fatalf("Expected the activity to be an AppendVariable activity")
+	}
+
+	output, err := flattenDataFactoryPipelineActivities(activities)
+	if err != nil {
+		t.Fatalf("Error flattening activities: %+v", err)
+	}
+	if !azureRmDataFactoryPipelineActivitiesDiff("", input, output, nil) {
+		t.Fatalf("Expected the flattened activities %q to match the input %q", output, input)
+	}
+
+	if _, err := expandDataFactoryPipelineActivities("{}"); err == nil {
+		t.Fatalf("Expected an error when the activities aren't a list")
+	}
+
+	empty, err := flattenDataFactoryPipelineActivities(&[]datafactory.BasicActivity{})
+	if err != nil || empty != "" {
+		t.Fatalf("Expected no activities to be flattened to an empty string")
+	}
+}
@@ -1,6 +1,8 @@
 package azurerm
 
 import (
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -8,6 +10,10 @@ func dataSourceArmAutomationVariableDateTime() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceArmAutomationVariableDateTimeRead,
 
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: datasourceAutomationVariableCommonSchema(schema.TypeString),
 	}
 }
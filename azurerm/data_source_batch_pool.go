@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -119,6 +120,13 @@ func dataSourceArmBatchPool() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"container_image_names": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 						"container_registries": {
 							Type:     schema.TypeList,
 							Computed: true,
@@ -176,6 +184,22 @@ func dataSourceArmBatchPool() *schema.Resource {
 					},
 				},
 			},
+			"application_package": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"start_task": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -279,7 +303,8 @@ func dataSourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error
 	accountName := d.Get("account_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Get(ctx, resourceGroup, accountName, name)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -327,6 +352,10 @@ func dataSourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("error setting `certificate`: %v", err)
 		}
 
+		if err := d.Set("application_package", azure.FlattenBatchPoolApplicationPackageReferences(props.ApplicationPackages)); err != nil {
+			return fmt.Errorf("error setting `application_package`: %v", err)
+		}
+
 		d.Set("start_task", azure.FlattenBatchPoolStartTask(props.StartTask))
 	}
 
@@ -2,10 +2,13 @@ package azurerm
 
 import (
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func dataSourceArmClientConfig() *schema.Resource {
@@ -25,6 +28,10 @@ func dataSourceArmClientConfig() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"service_principal_application_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -39,7 +46,15 @@ func dataSourceArmClientConfig() *schema.Resource {
 
 func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient)
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	// the Object ID is available from the Access Token for all authentication methods, unlike the Graph lookup below
+	objectId, err := azure.ObjectIdFromAuthorizer(client.resource.GroupsClient.Authorizer)
+	if err != nil {
+		log.Printf("[WARN] Unable to determine the Object ID of the authenticated principal: %+v", err)
+		objectId = ""
+	}
 
 	var servicePrincipal *graphrbac.ServicePrincipal
 	if client.usingServicePrincipal {
@@ -47,23 +62,23 @@ func dataSourceArmClientConfigRead(d *schema.ResourceData, meta interface{}) err
 		// Application & Service Principal is 1:1 per tenant. Since we know the appId (client_id)
 		// here, we can query for the Service Principal whose appId matches.
 		filter := fmt.Sprintf("appId eq '%s'", client.clientId)
+		// this is best-effort since the principal may not have permission to read from the Graph API, in
+		// which case the `service_principal_*` fields are left empty
 		listResult, listErr := spClient.List(ctx, filter)
-
 		if listErr != nil {
-			return fmt.Errorf("Error listing Service Principals: %#v", listErr)
-		}
-
-		if listResult.Values() == nil || len(listResult.Values()) != 1 {
-			return fmt.Errorf("Unexpected Service Principal query result: %#v", listResult.Values())
+			log.Printf("[WARN] Unable to list Service Principals - `service_principal_application_id` and `service_principal_object_id` will be empty: %+v", listErr)
+		} else if listResult.Values() == nil || len(listResult.Values()) != 1 {
+			log.Printf("[WARN] Unexpected Service Principal query result - `service_principal_application_id` and `service_principal_object_id` will be empty: %#v", listResult.Values())
+		} else {
+			servicePrincipal = &(listResult.Values())[0]
 		}
-
-		servicePrincipal = &(listResult.Values())[0]
 	}
 
 	d.SetId(time.Now().UTC().String())
 	d.Set("client_id", client.clientId)
 	d.Set("tenant_id", client.tenantId)
 	d.Set("subscription_id", client.subscriptionId)
+	d.Set("object_id", objectId)
 
 	if principal := servicePrincipal; principal != nil {
 		d.Set("service_principal_application_id", principal.AppID)
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,6 +24,12 @@ func dataSourceArmKubernetesServiceVersions() *schema.Resource {
 				Optional: true,
 			},
 
+			"include_preview": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"versions": {
 				Type:     schema.TypeList,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -39,7 +46,8 @@ func dataSourceArmKubernetesServiceVersions() *schema.Resource {
 
 func dataSourceArmKubernetesServiceVersionsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.ServicesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 
@@ -58,6 +66,7 @@ func dataSourceArmKubernetesServiceVersionsRead(d *schema.ResourceData, meta int
 
 	var versions []string
 	versionPrefix := d.Get("version_prefix").(string)
+	includePreview := d.Get("include_preview").(bool)
 
 	if props := listResp.OrchestratorVersionProfileProperties; props != nil {
 		if orchestrators := props.Orchestrators; orchestrators != nil {
@@ -79,6 +88,11 @@ func dataSourceArmKubernetesServiceVersionsRead(d *schema.ResourceData, meta int
 					continue
 				}
 
+				if !includePreview && rawV.IsPreview != nil && *rawV.IsPreview {
+					log.Printf("[DEBUG] Version %q is a preview version - skipping", kubeVersion)
+					continue
+				}
+
 				versions = append(versions, kubeVersion)
 				v, err := version.NewVersion(kubeVersion)
 				if err != nil {
@@ -93,6 +107,10 @@ func dataSourceArmKubernetesServiceVersionsRead(d *schema.ResourceData, meta int
 		}
 	}
 
+	if listResp.ID == nil {
+		return fmt.Errorf("Error retrieving Kubernetes Versions in %q: ID was nil", location)
+	}
+
 	d.SetId(*listResp.ID)
 	d.Set("versions", versions)
 	d.Set("latest_version", lv.Original())
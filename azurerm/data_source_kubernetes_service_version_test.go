@@ -54,6 +54,28 @@ func TestAccDataSourceAzureRMKubernetesServiceVersions_filtered(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceAzureRMKubernetesServiceVersions_nopreview(t *testing.T) {
+	dataSourceName := "data.azurerm_kubernetes_service_versions.test"
+	location := testLocation()
+	kvrx := regexp.MustCompile(k8sVersionRX)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMKubernetesServiceVersions_nopreview(location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "versions.#"),
+					resource.TestMatchResourceAttr(dataSourceName, "versions.0", kvrx),
+					resource.TestCheckResourceAttrSet(dataSourceName, "latest_version"),
+					resource.TestMatchResourceAttr(dataSourceName, "latest_version", kvrx),
+				),
+			},
+		},
+	})
+}
+
 func testAccDataSourceAzureRMKubernetesServiceVersions_basic(location string) string {
 	return fmt.Sprintf(`
 data "azurerm_kubernetes_service_versions" "test" {
@@ -70,3 +92,12 @@ data "azurerm_kubernetes_service_versions" "test" {
 }
 `, location)
 }
+
+func testAccDataSourceAzureRMKubernetesServiceVersions_nopreview(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_kubernetes_service_versions" "test" {
+  location        = "%s"
+  include_preview = false
+}
+`, location)
+}
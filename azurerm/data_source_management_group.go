@@ -1,10 +1,12 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -14,13 +16,17 @@ func dataSourceArmManagementGroup() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"display_name"},
 			},
 
 			"display_name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"group_id"},
 			},
 
 			"parent_management_group_id": {
@@ -40,9 +46,23 @@ func dataSourceArmManagementGroup() *schema.Resource {
 
 func dataSourceArmManagementGroupRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).managementGroups.GroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	groupId := d.Get("group_id").(string)
+	displayName := d.Get("display_name").(string)
+
+	if groupId == "" {
+		if displayName == "" {
+			return fmt.Errorf("Either `group_id` or `display_name` must be specified")
+		}
+
+		var err error
+		groupId, err = getManagementGroupIdByDisplayName(ctx, client, displayName)
+		if err != nil {
+			return err
+		}
+	}
 
 	recurse := true
 	resp, err := client.Get(ctx, groupId, "children", &recurse, "", managementGroupCacheControl)
@@ -108,3 +128,33 @@ func flattenArmManagementGroupDataSourceSubscriptionIds(input *[]managementgroup
 
 	return subscriptionIds, nil
 }
+
+func getManagementGroupIdByDisplayName(ctx context.Context, client *managementgroups.Client, displayName string) (string, error) {
+	matches := make([]string, 0)
+
+	iterator, err := client.ListComplete(ctx, managementGroupCacheControl, "")
+	if err != nil {
+		return "", fmt.Errorf("Error listing Management Groups: %+v", err)
+	}
+
+	for iterator.NotDone() {
+		group := iterator.Value()
+		if group.InfoProperties != nil && group.InfoProperties.DisplayName != nil && *group.InfoProperties.DisplayName == displayName && group.Name != nil {
+			matches = append(matches, *group.Name)
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return "", fmt.Errorf("Error listing Management Groups: %+v", err)
+		}
+	}
+
+	if len(matches) == 0 {
+		return "", fmt.Errorf("No Management Group was found with the Display Name %q", displayName)
+	}
+
+	if len(matches) > 1 {
+		return "", fmt.Errorf("Expected a single Management Group with the Display Name %q but got %d", displayName, len(matches))
+	}
+
+	return matches[0], nil
+}
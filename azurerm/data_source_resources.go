@@ -0,0 +1,183 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceArmResources() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validate.NoEmptyStrings,
+				ConflictsWith: []string{"name_regex"},
+			},
+
+			"name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.ValidateRegexp,
+				ConflictsWith: []string{"name"},
+			},
+
+			"resource_group_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"required_tags": tags.Schema(),
+
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"location": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tags.SchemaDataSource(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmResourcesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resource.ResourcesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+	resourceType := d.Get("type").(string)
+	requiredTags := d.Get("required_tags").(map[string]interface{})
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	filter := buildResourcesFilter(name, resourceType)
+
+	var results resources.ListResultIterator
+	var err error
+	if resourceGroup != "" {
+		results, err = client.ListByResourceGroupComplete(ctx, resourceGroup, filter, "", nil)
+	} else {
+		results, err = client.ListComplete(ctx, filter, "", nil)
+	}
+	if err != nil {
+		return fmt.Errorf("Error listing Resources (Filter %q): %+v", filter, err)
+	}
+
+	output := make([]interface{}, 0)
+	for results.NotDone() {
+		val := results.Value()
+
+		if err := results.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error going to the next Resource: %+v", err)
+		}
+
+		if val.ID == nil || val.Name == nil {
+			continue
+		}
+
+		if nameRegex != nil && !nameRegex.MatchString(*val.Name) {
+			continue
+		}
+
+		if !resourcesHaveRequiredTags(val.Tags, requiredTags) {
+			continue
+		}
+
+		resourceTypeName := ""
+		if val.Type != nil {
+			resourceTypeName = *val.Type
+		}
+
+		location := ""
+		if val.Location != nil {
+			location = azure.NormalizeLocation(*val.Location)
+		}
+
+		output = append(output, map[string]interface{}{
+			"name":     *val.Name,
+			"id":       *val.ID,
+			"type":     resourceTypeName,
+			"location": location,
+			"tags":     tags.Flatten(val.Tags),
+		})
+	}
+
+	d.SetId(time.Now().UTC().String())
+	if err := d.Set("resources", output); err != nil {
+		return fmt.Errorf("Error setting `resources`: %+v", err)
+	}
+
+	return nil
+}
+
+// buildResourcesFilter returns the OData filter for the name and type, since these can be filtered
+// by the API - however tags can't be combined with other filters, so they're filtered client-side
+func buildResourcesFilter(name string, resourceType string) string {
+	filters := make([]string, 0)
+	if name != "" {
+		filters = append(filters, fmt.Sprintf("name eq '%s'", escapeODataFilterValue(name)))
+	}
+	if resourceType != "" {
+		filters = append(filters, fmt.Sprintf("resourceType eq '%s'", escapeODataFilterValue(resourceType)))
+	}
+
+	return strings.Join(filters, " and ")
+}
+
+// escapeODataFilterValue escapes a single quote in a string literal by doubling it, as per the OData spec
+func escapeODataFilterValue(input string) string {
+	return strings.ReplaceAll(input, "'", "''")
+}
+
+func resourcesHaveRequiredTags(actual map[string]*string, required map[string]interface{}) bool {
+	for k, v := range required {
+		value, ok := actual[k]
+		if !ok || value == nil || *value != v.(string) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,180 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+)
+
+func TestBuildResourcesFilter(t *testing.T) {
+	cases := []struct {
+		Name         string
+		ResourceType string
+		Expected     string
+	}{
+		{
+			Expected: "",
+		},
+		{
+			Name:     "example",
+			Expected: "name eq 'example'",
+		},
+		{
+			ResourceType: "Microsoft.Network/virtualNetworks",
+			Expected:     "resourceType eq 'Microsoft.Network/virtualNetworks'",
+		},
+		{
+			Name:         "example",
+			ResourceType: "Microsoft.Network/virtualNetworks",
+			Expected:     "name eq 'example' and resourceType eq 'Microsoft.Network/virtualNetworks'",
+		},
+		{
+			Name:         "it's",
+			ResourceType: "Microsoft.Network/virtualNetworks' or name eq 'other",
+			Expected:     "name eq 'it''s' and resourceType eq 'Microsoft.Network/virtualNetworks'' or name eq ''other'",
+		},
+	}
+
+	for _, tc := range cases {
+		actual := buildResourcesFilter(tc.Name, tc.ResourceType)
+		if actual != tc.Expected {
+			t.Fatalf("Expected the filter for name %q and type %q to be %q but got %q", tc.Name, tc.ResourceType, tc.Expected, actual)
+		}
+	}
+}
+
+func TestAccDataSourceAzureRMResources_byName(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_byName(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.name", fmt.Sprintf("acctestvnet-first-%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.type", "Microsoft.Network/virtualNetworks"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMResources_byNameRegex(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_byNameRegex(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceAzureRMResources_byTypeAndRequiredTags(t *testing.T) {
+	dataSourceName := "data.azurerm_resources.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMResources_byTypeAndRequiredTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.name", fmt.Sprintf("acctestvnet-second-%d", ri)),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.0.tags.environment", "production"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMResources_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "first" {
+  name                = "acctestvnet-first-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags = {
+    environment = "staging"
+  }
+}
+
+resource "azurerm_virtual_network" "second" {
+  name                = "acctestvnet-second-%d"
+  address_space       = ["10.1.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  tags = {
+    environment = "production"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccDataSourceAzureRMResources_byName(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  name = "${azurerm_virtual_network.first.name}"
+}
+`, testAccDataSourceAzureRMResources_template(rInt, location))
+}
+
+func testAccDataSourceAzureRMResources_byNameRegex(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_virtual_network.first.resource_group_name}"
+  name_regex          = "^acctestvnet-[a-z]+-%d$"
+
+  depends_on = ["azurerm_virtual_network.second"]
+}
+`, testAccDataSourceAzureRMResources_template(rInt, location), rInt)
+}
+
+func testAccDataSourceAzureRMResources_byTypeAndRequiredTags(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resources" "test" {
+  resource_group_name = "${azurerm_virtual_network.first.resource_group_name}"
+  type                = "Microsoft.Network/virtualNetworks"
+
+  required_tags = {
+    environment = "production"
+  }
+
+  depends_on = ["azurerm_virtual_network.second"]
+}
+`, testAccDataSourceAzureRMResources_template(rInt, location))
+}
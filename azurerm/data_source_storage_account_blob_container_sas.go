@@ -3,6 +3,8 @@ package azurerm
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/storage"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -39,21 +41,28 @@ func dataSourceArmStorageAccountBlobContainerSharedAccessSignature() *schema.Res
 				ValidateFunc: validate.SharedAccessSignatureIP,
 			},
 
+			"stored_access_policy_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// `start`, `expiry` and `permissions` can be omitted when they're defined by the Stored Access Policy
 			"start": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validate.ISO8601DateTime,
 			},
 
 			"expiry": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ValidateFunc: validate.ISO8601DateTime,
 			},
 
 			"permissions": {
 				Type:     schema.TypeList,
-				Required: true,
+				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -134,6 +143,11 @@ func dataSourceArmStorageContainerSasRead(d *schema.ResourceData, _ interface{})
 	start := d.Get("start").(string)
 	expiry := d.Get("expiry").(string)
 	permissionsIface := d.Get("permissions").([]interface{})
+	storedAccessPolicyId := d.Get("stored_access_policy_id").(string)
+
+	if storedAccessPolicyId == "" && (start == "" || expiry == "" || len(permissionsIface) == 0) {
+		return fmt.Errorf("`start`, `expiry` and `permissions` must be specified when `stored_access_policy_id` isn't set")
+	}
 
 	// response headers
 	cacheControl := d.Get("cache_control").(string)
@@ -142,7 +156,10 @@ func dataSourceArmStorageContainerSasRead(d *schema.ResourceData, _ interface{})
 	contentLanguage := d.Get("content_language").(string)
 	contentType := d.Get("content_type").(string)
 
-	permissions := buildContainerPermissionsString(permissionsIface[0].(map[string]interface{}))
+	permissions := ""
+	if len(permissionsIface) > 0 && permissionsIface[0] != nil {
+		permissions = buildContainerPermissionsString(permissionsIface[0].(map[string]interface{}))
+	}
 
 	// Parse the connection string
 	kvp, err := storage.ParseAccountSASConnectionString(connString)
@@ -158,7 +175,7 @@ func dataSourceArmStorageContainerSasRead(d *schema.ResourceData, _ interface{})
 		signedProtocol = "https"
 	}
 	signedIp := ip
-	signedIdentifier := ""
+	signedIdentifier := storedAccessPolicyId
 	signedSnapshotTime := ""
 
 	sasToken, err := storage.ComputeContainerSASToken(permissions, start, expiry, accountName, accountKey,
@@ -168,6 +185,9 @@ func dataSourceArmStorageContainerSasRead(d *schema.ResourceData, _ interface{})
 		return err
 	}
 
+	// the values which aren't specified are taken from the Stored Access Policy, so mustn't be sent as empty values
+	sasToken = removeEmptySasTokenParameters(sasToken, "st", "se", "sp")
+
 	d.Set("sas", sasToken)
 	tokenHash := sha256.Sum256([]byte(sasToken))
 	d.SetId(hex.EncodeToString(tokenHash[:]))
@@ -175,6 +195,27 @@ func dataSourceArmStorageContainerSasRead(d *schema.ResourceData, _ interface{})
 	return nil
 }
 
+func removeEmptySasTokenParameters(sasToken string, keys ...string) string {
+	parameters := strings.Split(strings.TrimPrefix(sasToken, "?"), "&")
+	output := make([]string, 0)
+
+	for _, parameter := range parameters {
+		isEmpty := false
+		for _, key := range keys {
+			if parameter == key+"=" {
+				isEmpty = true
+				break
+			}
+		}
+
+		if !isEmpty {
+			output = append(output, parameter)
+		}
+	}
+
+	return "?" + strings.Join(output, "&")
+}
+
 func buildContainerPermissionsString(perms map[string]interface{}) string {
 	retVal := ""
 
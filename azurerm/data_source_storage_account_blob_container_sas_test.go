@@ -100,6 +100,74 @@ data "azurerm_storage_account_blob_container_sas" "test" {
 `, rInt, location, rString, startDate, endDate)
 }
 
+func TestAccDataSourceArmStorageAccountBlobContainerSas_storedAccessPolicy(t *testing.T) {
+	dataSourceName := "data.azurerm_storage_account_blob_container_sas.test"
+	rInt := tf.AccRandTimeInt()
+	rString := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMStorageAccountBlobContainerSas_storedAccessPolicy(rInt, rString, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "stored_access_policy_id", "policy1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sas"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMStorageAccountBlobContainerSas_storedAccessPolicy(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "rg" {
+  name     = "acctestsa-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "storage" {
+  name                     = "acctestsads%s"
+  resource_group_name      = "${azurerm_resource_group.rg.name}"
+  location                 = "${azurerm_resource_group.rg.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "container" {
+  name                  = "sas-test"
+  storage_account_name  = "${azurerm_storage_account.storage.name}"
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string       = "${azurerm_storage_account.storage.primary_connection_string}"
+  container_name          = "${azurerm_storage_container.container.name}"
+  stored_access_policy_id = "policy1"
+}
+`, rInt, location, rString)
+}
+
+func TestAccDataSourceArmStorageAccountBlobContainerSas_removeEmptyParameters(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"?sv=2018-11-09&sr=c&st=2019-01-01&se=2019-01-02&sp=r&sig=abc", "?sv=2018-11-09&sr=c&st=2019-01-01&se=2019-01-02&sp=r&sig=abc"},
+		{"?sv=2018-11-09&sr=c&st=&se=&sp=&si=policy1&sig=abc", "?sv=2018-11-09&sr=c&si=policy1&sig=abc"},
+		{"?sv=2018-11-09&sr=c&st=&se=2019-01-02&sp=rl&si=policy1&sig=abc", "?sv=2018-11-09&sr=c&se=2019-01-02&sp=rl&si=policy1&sig=abc"},
+	}
+
+	for _, test := range testCases {
+		result := removeEmptySasTokenParameters(test.input, "st", "se", "sp")
+		if test.expected != result {
+			t.Fatalf("Failed to remove empty parameters: expected: %s, result: %s", test.expected, result)
+		}
+	}
+}
+
 func TestAccDataSourceArmStorageAccountBlobContainerSas_permissionsString(t *testing.T) {
 	testCases := []struct {
 		input    map[string]interface{}
@@ -36,6 +36,12 @@ func dataSourceArmStorageAccountSharedAccessSignature() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"ip_addresses": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.SharedAccessSignatureIP,
+			},
+
 			"resource_types": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -186,6 +192,7 @@ func dataSourceArmStorageAccountSasRead(d *schema.ResourceData, _ interface{}) e
 
 	connString := d.Get("connection_string").(string)
 	httpsOnly := d.Get("https_only").(bool)
+	ipAddresses := d.Get("ip_addresses").(string)
 	resourceTypesIface := d.Get("resource_types").([]interface{})
 	servicesIface := d.Get("services").([]interface{})
 	start := d.Get("start").(string)
@@ -212,7 +219,7 @@ func dataSourceArmStorageAccountSasRead(d *schema.ResourceData, _ interface{}) e
 	if httpsOnly {
 		signedProtocol = "https"
 	}
-	signedIp := ""
+	signedIp := ipAddresses
 	signedVersion := sasSignedVersion
 
 	sasToken, err := storage.ComputeAccountSASToken(accountName, accountKey, permissions, services, resourceTypes,
@@ -0,0 +1,61 @@
+package azure
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ObjectIdFromAuthorizer returns the Object ID of the principal which the Authorizer authenticates as,
+// which is decoded from the claims of the Access Token rather than looked up via the Graph API, so that
+// it's available regardless of the authentication method or the permissions granted to the principal.
+// Not every Access Token contains an Object ID, in which case an empty string is returned
+func ObjectIdFromAuthorizer(authorizer autorest.Authorizer) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, "https://management.azure.com", nil)
+	if err != nil {
+		return "", fmt.Errorf("Error building request: %+v", err)
+	}
+
+	req, err = autorest.Prepare(req, authorizer.WithAuthorization())
+	if err != nil {
+		return "", fmt.Errorf("Error obtaining Access Token: %+v", err)
+	}
+
+	header := req.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return "", fmt.Errorf("Expected a Bearer Token in the Authorization header")
+	}
+
+	return objectIdFromAccessToken(strings.TrimPrefix(header, "Bearer "))
+}
+
+func objectIdFromAccessToken(token string) (string, error) {
+	// a JWT is made up of the header, claims and signature separated by a `.`
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return "", fmt.Errorf("Expected the Access Token to contain 3 segments but got %d", len(segments))
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return "", fmt.Errorf("Error decoding the claims of the Access Token: %+v", err)
+	}
+
+	var claims struct {
+		ObjectId string `json:"oid"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return "", fmt.Errorf("Error parsing the claims of the Access Token: %+v", err)
+	}
+
+	if claims.ObjectId == "" {
+		log.Printf("[WARN] The Access Token doesn't contain an Object ID (`oid`) claim")
+	}
+
+	return claims.ObjectId, nil
+}
@@ -0,0 +1,97 @@
+package azure
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func testAccessTokenWithClaims(claims string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	payload := base64.RawURLEncoding.EncodeToString([]byte(claims))
+	return fmt.Sprintf("%s.%s.signature", header, payload)
+}
+
+func TestObjectIdFromAccessToken(t *testing.T) {
+	cases := []struct {
+		Token    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Token: "",
+			Error: true,
+		},
+		{
+			Token: "not-a-token",
+			Error: true,
+		},
+		{
+			Token: "header.!!!.signature",
+			Error: true,
+		},
+		{
+			Token:    testAccessTokenWithClaims(`{"tid":"11111111-1111-1111-1111-111111111111"}`),
+			Expected: "",
+		},
+		{
+			Token:    testAccessTokenWithClaims(`{"oid":"00000000-0000-0000-0000-000000000000","tid":"11111111-1111-1111-1111-111111111111"}`),
+			Expected: "00000000-0000-0000-0000-000000000000",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Token, func(t *testing.T) {
+			actual, err := objectIdFromAccessToken(tc.Token)
+			if err != nil {
+				if tc.Error {
+					return
+				}
+
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if tc.Error {
+				t.Fatalf("Expected an error but got %q", actual)
+			}
+
+			if actual != tc.Expected {
+				t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestObjectIdFromAuthorizer(t *testing.T) {
+	token := testAccessTokenWithClaims(`{"oid":"00000000-0000-0000-0000-000000000000"}`)
+	actual, err := ObjectIdFromAuthorizer(testStaticAuthorizer{token: token})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if actual != "00000000-0000-0000-0000-000000000000" {
+		t.Fatalf("Expected %q but got %q", "00000000-0000-0000-0000-000000000000", actual)
+	}
+}
+
+func TestObjectIdFromAuthorizerWithoutObjectIdClaim(t *testing.T) {
+	token := testAccessTokenWithClaims(`{"tid":"11111111-1111-1111-1111-111111111111"}`)
+	actual, err := ObjectIdFromAuthorizer(testStaticAuthorizer{token: token})
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if actual != "" {
+		t.Fatalf("Expected an empty Object ID but got %q", actual)
+	}
+}
+
+type testStaticAuthorizer struct {
+	token string
+}
+
+func (a testStaticAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return autorest.WithHeader("Authorization", fmt.Sprintf("Bearer %s", a.token))
+}
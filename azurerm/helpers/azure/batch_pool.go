@@ -192,6 +192,26 @@ func FlattenBatchPoolCertificateReferences(armCertificates *[]batch.CertificateR
 	return output
 }
 
+// FlattenBatchPoolApplicationPackageReferences flattens the Batch pool application package references
+func FlattenBatchPoolApplicationPackageReferences(armApplicationPackages *[]batch.ApplicationPackageReference) []interface{} {
+	output := make([]interface{}, 0)
+	if armApplicationPackages == nil {
+		return output
+	}
+
+	for _, armApplicationPackage := range *armApplicationPackages {
+		applicationPackage := map[string]interface{}{}
+		if armApplicationPackage.ID != nil {
+			applicationPackage["id"] = *armApplicationPackage.ID
+		}
+		if armApplicationPackage.Version != nil {
+			applicationPackage["version"] = *armApplicationPackage.Version
+		}
+		output = append(output, applicationPackage)
+	}
+	return output
+}
+
 // FlattenBatchPoolContainerConfiguration flattens a Batch pool container configuration
 func FlattenBatchPoolContainerConfiguration(d *schema.ResourceData, armContainerConfiguration *batch.ContainerConfiguration) interface{} {
 	result := make(map[string]interface{})
@@ -203,6 +223,13 @@ func FlattenBatchPoolContainerConfiguration(d *schema.ResourceData, armContainer
 	if armContainerConfiguration.Type != nil {
 		result["type"] = *armContainerConfiguration.Type
 	}
+	containerImageNames := make([]interface{}, 0)
+	if armContainerConfiguration.ContainerImageNames != nil {
+		for _, imageName := range *armContainerConfiguration.ContainerImageNames {
+			containerImageNames = append(containerImageNames, imageName)
+		}
+	}
+	result["container_image_names"] = schema.NewSet(schema.HashString, containerImageNames)
 	result["container_registries"] = flattenBatchPoolContainerRegistries(d, armContainerConfiguration.ContainerRegistries)
 
 	return []interface{}{result}
@@ -317,6 +344,7 @@ func ExpandBatchPoolContainerConfiguration(list []interface{}) (*batch.Container
 
 	containerConf := &batch.ContainerConfiguration{
 		Type:                &containerType,
+		ContainerImageNames: utils.ExpandStringSlice(containerConfiguration["container_image_names"].(*schema.Set).List()),
 		ContainerRegistries: containerRegistries,
 	}
 
@@ -350,6 +378,23 @@ func expandBatchPoolContainerRegistry(ref map[string]interface{}) (*batch.Contai
 	return &containerRegistry, nil
 }
 
+// ExpandBatchPoolApplicationPackageReferences expands the Batch pool application package references
+func ExpandBatchPoolApplicationPackageReferences(list []interface{}) *[]batch.ApplicationPackageReference {
+	result := make([]batch.ApplicationPackageReference, 0)
+
+	for _, tempItem := range list {
+		item := tempItem.(map[string]interface{})
+		applicationPackage := batch.ApplicationPackageReference{
+			ID: utils.String(item["id"].(string)),
+		}
+		if version := item["version"].(string); version != "" {
+			applicationPackage.Version = utils.String(version)
+		}
+		result = append(result, applicationPackage)
+	}
+	return &result
+}
+
 // ExpandBatchPoolCertificateReferences expands Batch pool certificate references
 func ExpandBatchPoolCertificateReferences(list []interface{}) (*[]batch.CertificateReference, error) {
 	var result []batch.CertificateReference
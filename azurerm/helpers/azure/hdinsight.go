@@ -2,6 +2,7 @@ package azure
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -145,6 +146,257 @@ func FlattenHDInsightsConfigurations(input map[string]*string) []interface{} {
 	}
 }
 
+func SchemaHDInsightsExternalMetastores() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"hive": schemaHDInsightsExternalMetastore(),
+
+				"oozie": schemaHDInsightsExternalMetastore(),
+
+				"ambari": schemaHDInsightsExternalMetastore(),
+			},
+		},
+	}
+}
+
+func schemaHDInsightsExternalMetastore() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"server": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"database_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"username": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+				"password": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					Sensitive:    true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+// ExpandHDInsightsExternalMetastores adds the configurations for the external metastores
+// (which are all Azure SQL Databases using SQL authentication) to the specified configurations
+func ExpandHDInsightsExternalMetastores(input []interface{}, configurations map[string]interface{}) {
+	if len(input) == 0 || input[0] == nil {
+		return
+	}
+
+	vs := input[0].(map[string]interface{})
+
+	if v := vs["hive"].([]interface{}); len(v) > 0 {
+		server, database, username, password := expandHDInsightsExternalMetastore(v)
+		configurations["hive-site"] = map[string]interface{}{
+			"javax.jdo.option.ConnectionDriverName": "com.microsoft.sqlserver.jdbc.SQLServerDriver",
+			"javax.jdo.option.ConnectionURL":        hdInsightsExternalMetastoreConnectionURL(server, database),
+			"javax.jdo.option.ConnectionUserName":   username,
+			"javax.jdo.option.ConnectionPassword":   password,
+		}
+		configurations["hive-env"] = map[string]interface{}{
+			"hive_database":                       "Existing MSSQL Server database with SQL authentication",
+			"hive_database_name":                  database,
+			"hive_database_type":                  "mssql",
+			"hive_existing_mssql_server_database": database,
+			"hive_existing_mssql_server_host":     server,
+			"hive_hostname":                       server,
+		}
+	}
+
+	if v := vs["oozie"].([]interface{}); len(v) > 0 {
+		server, database, username, password := expandHDInsightsExternalMetastore(v)
+		configurations["oozie-site"] = map[string]interface{}{
+			"oozie.service.JPAService.jdbc.driver":   "com.microsoft.sqlserver.jdbc.SQLServerDriver",
+			"oozie.service.JPAService.jdbc.url":      hdInsightsExternalMetastoreConnectionURL(server, database),
+			"oozie.service.JPAService.jdbc.username": username,
+			"oozie.service.JPAService.jdbc.password": password,
+			"oozie.db.schema.name":                   "oozie",
+		}
+		configurations["oozie-env"] = map[string]interface{}{
+			"oozie_database":                       "Existing MSSQL Server database with SQL authentication",
+			"oozie_database_name":                  database,
+			"oozie_database_type":                  "mssql",
+			"oozie_existing_mssql_server_database": database,
+			"oozie_existing_mssql_server_host":     server,
+			"oozie_hostname":                       server,
+		}
+	}
+
+	if v := vs["ambari"].([]interface{}); len(v) > 0 {
+		server, database, username, password := expandHDInsightsExternalMetastore(v)
+		configurations["ambari-conf"] = map[string]interface{}{
+			"database-server":        server,
+			"database-name":          database,
+			"database-user-name":     username,
+			"database-user-password": password,
+		}
+	}
+}
+
+func expandHDInsightsExternalMetastore(input []interface{}) (server, database, username, password string) {
+	v := input[0].(map[string]interface{})
+	return v["server"].(string), v["database_name"].(string), v["username"].(string), v["password"].(string)
+}
+
+func hdInsightsExternalMetastoreConnectionURL(server, database string) string {
+	return fmt.Sprintf("jdbc:sqlserver://%s;database=%s;encrypt=true;trustServerCertificate=true;create=false;loginTimeout=300", server, database)
+}
+
+func FlattenHDInsightsExternalMetastores(input map[string]map[string]*string, existing []interface{}) []interface{} {
+	// the passwords aren't returned from the API, so we need to look them up to not force a diff
+	existingPasswords := map[string]string{}
+	if len(existing) > 0 && existing[0] != nil {
+		existingV := existing[0].(map[string]interface{})
+		for _, key := range []string{"hive", "oozie", "ambari"} {
+			if v, ok := existingV[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				existingPasswords[key] = v[0].(map[string]interface{})["password"].(string)
+			}
+		}
+	}
+
+	output := map[string]interface{}{
+		"hive":   []interface{}{},
+		"oozie":  []interface{}{},
+		"ambari": []interface{}{},
+	}
+
+	// the Hive and Oozie metastores default to an internal database when no external metastore is used
+	if env := input["hive-env"]; hdInsightsConfigurationValue(env, "hive_database_type") == "mssql" {
+		output["hive"] = flattenHDInsightsExternalMetastore(
+			hdInsightsConfigurationValue(env, "hive_existing_mssql_server_host"),
+			hdInsightsConfigurationValue(env, "hive_existing_mssql_server_database"),
+			hdInsightsConfigurationValue(input["hive-site"], "javax.jdo.option.ConnectionUserName"),
+			existingPasswords["hive"])
+	}
+
+	if env := input["oozie-env"]; hdInsightsConfigurationValue(env, "oozie_database_type") == "mssql" {
+		output["oozie"] = flattenHDInsightsExternalMetastore(
+			hdInsightsConfigurationValue(env, "oozie_existing_mssql_server_host"),
+			hdInsightsConfigurationValue(env, "oozie_existing_mssql_server_database"),
+			hdInsightsConfigurationValue(input["oozie-site"], "oozie.service.JPAService.jdbc.username"),
+			existingPasswords["oozie"])
+	}
+
+	if conf := input["ambari-conf"]; hdInsightsConfigurationValue(conf, "database-server") != "" {
+		output["ambari"] = flattenHDInsightsExternalMetastore(
+			hdInsightsConfigurationValue(conf, "database-server"),
+			hdInsightsConfigurationValue(conf, "database-name"),
+			hdInsightsConfigurationValue(conf, "database-user-name"),
+			existingPasswords["ambari"])
+	}
+
+	if len(output["hive"].([]interface{})) == 0 && len(output["oozie"].([]interface{})) == 0 && len(output["ambari"].([]interface{})) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{output}
+}
+
+func flattenHDInsightsExternalMetastore(server, database, username, password string) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"server":        server,
+			"database_name": database,
+			"username":      username,
+			"password":      password,
+		},
+	}
+}
+
+func hdInsightsConfigurationValue(input map[string]*string, key string) string {
+	if input == nil {
+		return ""
+	}
+
+	if v, ok := input[key]; ok && v != nil {
+		return *v
+	}
+
+	return ""
+}
+
+func SchemaHDInsightsMonitor() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"log_analytics_workspace_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.UUID,
+				},
+				"primary_key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Sensitive:    true,
+					ValidateFunc: validate.NoEmptyStrings,
+				},
+			},
+		},
+	}
+}
+
+func ExpandHDInsightsMonitor(input []interface{}) hdinsight.ClusterMonitoringRequest {
+	v := input[0].(map[string]interface{})
+
+	return hdinsight.ClusterMonitoringRequest{
+		WorkspaceID: utils.String(v["log_analytics_workspace_id"].(string)),
+		PrimaryKey:  utils.String(v["primary_key"].(string)),
+	}
+}
+
+func FlattenHDInsightsMonitor(input hdinsight.ClusterMonitoringResponse, existing []interface{}) []interface{} {
+	if input.ClusterMonitoringEnabled == nil || !*input.ClusterMonitoringEnabled {
+		return []interface{}{}
+	}
+
+	workspaceId := ""
+	if input.WorkspaceID != nil {
+		workspaceId = *input.WorkspaceID
+	}
+
+	// the primary key isn't returned from the API, so we need to look it up to not force a diff
+	primaryKey := ""
+	if len(existing) > 0 && existing[0] != nil {
+		primaryKey = existing[0].(map[string]interface{})["primary_key"].(string)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"log_analytics_workspace_id": workspaceId,
+			"primary_key":                primaryKey,
+		},
+	}
+}
+
 func SchemaHDInsightsStorageAccounts() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -210,6 +462,8 @@ type HDInsightNodeDefinition struct {
 	MaxNumberOfDisksPerNode  *int
 	FixedMinInstanceCount    *int32
 	FixedTargetInstanceCount *int32
+	CanAutoScaleByCapacity   bool
+	CanAutoScaleOnSchedule   bool
 }
 
 func SchemaHDInsightNodeDefinition(schemaLocation string, definition HDInsightNodeDefinition) *schema.Schema {
@@ -367,6 +621,10 @@ func SchemaHDInsightNodeDefinition(schemaLocation string, definition HDInsightNo
 		}
 	}
 
+	if definition.CanAutoScaleByCapacity || definition.CanAutoScaleOnSchedule {
+		result["autoscale"] = schemaHDInsightNodeDefinitionAutoScale(schemaLocation, definition)
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeList,
 		Required: true,
@@ -377,6 +635,116 @@ func SchemaHDInsightNodeDefinition(schemaLocation string, definition HDInsightNo
 	}
 }
 
+func schemaHDInsightNodeDefinitionAutoScale(schemaLocation string, definition HDInsightNodeDefinition) *schema.Schema {
+	autoScale := map[string]*schema.Schema{}
+
+	if definition.CanAutoScaleByCapacity {
+		autoScale["capacity"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			ConflictsWith: []string{
+				fmt.Sprintf("%s.0.autoscale.0.recurrence", schemaLocation),
+			},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"min_instance_count": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(definition.MinInstanceCount, definition.MaxInstanceCount),
+					},
+					"max_instance_count": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IntBetween(definition.MinInstanceCount, definition.MaxInstanceCount),
+					},
+				},
+			},
+		}
+	}
+
+	if definition.CanAutoScaleOnSchedule {
+		recurrence := &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"timezone": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validate.NoEmptyStrings,
+					},
+					"schedule": {
+						Type:     schema.TypeList,
+						Required: true,
+						ForceNew: true,
+						MinItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"days": {
+									Type:     schema.TypeList,
+									Required: true,
+									ForceNew: true,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(hdinsight.Monday),
+											string(hdinsight.Tuesday),
+											string(hdinsight.Wednesday),
+											string(hdinsight.Thursday),
+											string(hdinsight.Friday),
+											string(hdinsight.Saturday),
+											string(hdinsight.Sunday),
+										}, false),
+									},
+								},
+								"time": {
+									Type:     schema.TypeString,
+									Required: true,
+									ForceNew: true,
+									ValidateFunc: validation.StringMatch(
+										regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`),
+										"`time` must be in the 24-hour format `HH:MM`",
+									),
+								},
+								"target_instance_count": {
+									Type:         schema.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntBetween(definition.MinInstanceCount, definition.MaxInstanceCount),
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		if definition.CanAutoScaleByCapacity {
+			recurrence.ConflictsWith = []string{
+				fmt.Sprintf("%s.0.autoscale.0.capacity", schemaLocation),
+			}
+		}
+		autoScale["recurrence"] = recurrence
+	}
+
+	// the API doesn't allow the autoscale configuration of an existing cluster to be updated
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: autoScale,
+		},
+	}
+}
+
 func ExpandHDInsightNodeDefinition(name string, input []interface{}, definition HDInsightNodeDefinition) (*hdinsight.Role, error) {
 	v := input[0].(map[string]interface{})
 	vmSize := v["vm_size"].(string)
@@ -452,9 +820,66 @@ func ExpandHDInsightNodeDefinition(name string, input []interface{}, definition
 		}
 	}
 
+	if definition.CanAutoScaleByCapacity || definition.CanAutoScaleOnSchedule {
+		role.AutoscaleConfiguration = expandHDInsightNodeDefinitionAutoScale(v["autoscale"].([]interface{}))
+	}
+
 	return &role, nil
 }
 
+func expandHDInsightNodeDefinitionAutoScale(input []interface{}) *hdinsight.Autoscale {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	autoScale := hdinsight.Autoscale{}
+
+	if capacityRaw, ok := v["capacity"].([]interface{}); ok && len(capacityRaw) > 0 {
+		capacity := capacityRaw[0].(map[string]interface{})
+		autoScale.Capacity = &hdinsight.AutoscaleCapacity{
+			MinInstanceCount: utils.Int32(int32(capacity["min_instance_count"].(int))),
+			MaxInstanceCount: utils.Int32(int32(capacity["max_instance_count"].(int))),
+		}
+	}
+
+	if recurrenceRaw, ok := v["recurrence"].([]interface{}); ok && len(recurrenceRaw) > 0 {
+		recurrence := recurrenceRaw[0].(map[string]interface{})
+
+		schedules := make([]hdinsight.AutoscaleSchedule, 0)
+		for _, scheduleRaw := range recurrence["schedule"].([]interface{}) {
+			schedule := scheduleRaw.(map[string]interface{})
+
+			days := make([]hdinsight.DaysOfWeek, 0)
+			for _, day := range schedule["days"].([]interface{}) {
+				days = append(days, hdinsight.DaysOfWeek(day.(string)))
+			}
+
+			// a schedule-based rule scales to a fixed size, so the min and max are the same
+			targetInstanceCount := utils.Int32(int32(schedule["target_instance_count"].(int)))
+			schedules = append(schedules, hdinsight.AutoscaleSchedule{
+				Days: &days,
+				TimeAndCapacity: &hdinsight.AutoscaleTimeAndCapacity{
+					Time:             utils.String(schedule["time"].(string)),
+					MinInstanceCount: targetInstanceCount,
+					MaxInstanceCount: targetInstanceCount,
+				},
+			})
+		}
+
+		autoScale.Recurrence = &hdinsight.AutoscaleRecurrence{
+			TimeZone: utils.String(recurrence["timezone"].(string)),
+			Schedule: &schedules,
+		}
+	}
+
+	if autoScale.Capacity == nil && autoScale.Recurrence == nil {
+		return nil
+	}
+
+	return &autoScale
+}
+
 func FlattenHDInsightNodeDefinition(input *hdinsight.Role, existing []interface{}, definition HDInsightNodeDefinition) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -526,6 +951,84 @@ func FlattenHDInsightNodeDefinition(input *hdinsight.Role, existing []interface{
 		}
 	}
 
+	if definition.CanAutoScaleByCapacity || definition.CanAutoScaleOnSchedule {
+		output["autoscale"] = flattenHDInsightNodeDefinitionAutoScale(input.AutoscaleConfiguration, definition)
+	}
+
+	return []interface{}{output}
+}
+
+func flattenHDInsightNodeDefinitionAutoScale(input *hdinsight.Autoscale, definition HDInsightNodeDefinition) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := map[string]interface{}{}
+
+	if definition.CanAutoScaleByCapacity {
+		capacities := make([]interface{}, 0)
+		if capacity := input.Capacity; capacity != nil {
+			minInstanceCount := 0
+			if capacity.MinInstanceCount != nil {
+				minInstanceCount = int(*capacity.MinInstanceCount)
+			}
+			maxInstanceCount := 0
+			if capacity.MaxInstanceCount != nil {
+				maxInstanceCount = int(*capacity.MaxInstanceCount)
+			}
+			capacities = append(capacities, map[string]interface{}{
+				"min_instance_count": minInstanceCount,
+				"max_instance_count": maxInstanceCount,
+			})
+		}
+		output["capacity"] = capacities
+	}
+
+	if definition.CanAutoScaleOnSchedule {
+		recurrences := make([]interface{}, 0)
+		if recurrence := input.Recurrence; recurrence != nil {
+			timeZone := ""
+			if recurrence.TimeZone != nil {
+				timeZone = *recurrence.TimeZone
+			}
+
+			schedules := make([]interface{}, 0)
+			if recurrence.Schedule != nil {
+				for _, schedule := range *recurrence.Schedule {
+					days := make([]interface{}, 0)
+					if schedule.Days != nil {
+						for _, day := range *schedule.Days {
+							days = append(days, string(day))
+						}
+					}
+
+					time := ""
+					targetInstanceCount := 0
+					if capacity := schedule.TimeAndCapacity; capacity != nil {
+						if capacity.Time != nil {
+							time = *capacity.Time
+						}
+						if capacity.MaxInstanceCount != nil {
+							targetInstanceCount = int(*capacity.MaxInstanceCount)
+						}
+					}
+
+					schedules = append(schedules, map[string]interface{}{
+						"days":                  days,
+						"time":                  time,
+						"target_instance_count": targetInstanceCount,
+					})
+				}
+			}
+
+			recurrences = append(recurrences, map[string]interface{}{
+				"timezone": timeZone,
+				"schedule": schedules,
+			})
+		}
+		output["recurrence"] = recurrences
+	}
+
 	return []interface{}{output}
 }
 
@@ -2,6 +2,9 @@ package azure
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
 )
 
 func ValidateResourceID(i interface{}, k string) (warnings []string, errors []error) {
@@ -18,7 +21,7 @@ func ValidateResourceID(i interface{}, k string) (warnings []string, errors []er
 	return warnings, errors
 }
 
-//true for a resource ID or an empty string
+// true for a resource ID or an empty string
 func ValidateResourceIDOrEmpty(i interface{}, k string) (_ []string, errors []error) {
 	v, ok := i.(string)
 	if !ok {
@@ -32,3 +35,64 @@ func ValidateResourceIDOrEmpty(i interface{}, k string) (_ []string, errors []er
 
 	return ValidateResourceID(i, k)
 }
+
+// ValidateResourceIDOfType returns a SchemaValidateFunc which checks that the value is a valid
+// resource id for the given resource type (e.g. `Microsoft.Network/virtualNetworks/subnets`)
+func ValidateResourceIDOfType(resourceType string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		warnings, errors = ValidateResourceID(i, k)
+		if len(errors) > 0 {
+			return warnings, errors
+		}
+
+		actualType := ResourceTypeFromID(i.(string))
+		if !strings.EqualFold(actualType, resourceType) {
+			errors = append(errors, fmt.Errorf("expected %q to be the ID of a %q resource but got the ID of a %q resource", k, resourceType, actualType))
+		}
+
+		return warnings, errors
+	}
+}
+
+// ValidateResourceIDOfTypeOrEmpty returns a SchemaValidateFunc which checks that the value is either
+// empty or a valid resource id for the given resource type
+func ValidateResourceIDOfTypeOrEmpty(resourceType string) schema.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+			return
+		}
+
+		if v == "" {
+			return
+		}
+
+		return ValidateResourceIDOfType(resourceType)(i, k)
+	}
+}
+
+// ResourceTypeFromID returns the fully qualified type of the resource (the Provider namespace
+// followed by the resource types) which the ID refers to - for example an ID for a Subnet
+// returns `Microsoft.Network/virtualNetworks/subnets`
+func ResourceTypeFromID(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+
+	// Extension resources are nested beneath another resource, so we only want the last provider
+	index := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") {
+			index = i
+		}
+	}
+	if index == -1 || index+1 >= len(segments) {
+		return ""
+	}
+
+	types := []string{segments[index+1]}
+	for i := index + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	return strings.Join(types, "/")
+}
@@ -90,3 +90,85 @@ func TestAzureResourceIDOrEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureResourceIDOfType(t *testing.T) {
+	cases := []struct {
+		ID     string
+		Errors int
+	}{
+		{
+			ID:     "",
+			Errors: 1,
+		},
+		{
+			ID:     "nonsense",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/group1/securityRules/rule1",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Errors: 0,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1/subnets/subnet1",
+			Errors: 0,
+		},
+	}
+
+	validateFunc := ValidateResourceIDOfType("Microsoft.Network/virtualNetworks/subnets")
+	for _, tc := range cases {
+		t.Run(tc.ID, func(t *testing.T) {
+			_, errors := validateFunc(tc.ID, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected ValidateResourceIDOfType to have %d not %d errors for %q", tc.Errors, len(errors), tc.ID)
+			}
+		})
+	}
+}
+
+func TestAzureResourceIDOfTypeOrEmpty(t *testing.T) {
+	cases := []struct {
+		ID     string
+		Errors int
+	}{
+		{
+			ID:     "",
+			Errors: 0,
+		},
+		{
+			ID:     "nonsense",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Errors: 1,
+		},
+		{
+			ID:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Errors: 0,
+		},
+	}
+
+	validateFunc := ValidateResourceIDOfTypeOrEmpty("Microsoft.Network/virtualNetworks/subnets")
+	for _, tc := range cases {
+		t.Run(tc.ID, func(t *testing.T) {
+			_, errors := validateFunc(tc.ID, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected ValidateResourceIDOfTypeOrEmpty to have %d not %d errors for %q", tc.Errors, len(errors), tc.ID)
+			}
+		})
+	}
+}
@@ -39,6 +39,12 @@ func TestCaseDifference(t *testing.T) {
 			StringB:  "Ye OLD texT?",
 			Suppress: true,
 		},
+		{
+			Name:     "resource id different case",
+			StringA:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			StringB:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/microsoft.network/virtualNetworks/network1",
+			Suppress: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -3,6 +3,7 @@ package tf
 import "fmt"
 
 func ImportAsExistsError(resourceName, id string) error {
-	msg := "A resource with the ID %q already exists - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information."
-	return fmt.Errorf(msg, id, resourceName)
+	msg := "A resource with the ID %q already exists - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information.\n\n" +
+		"To import this resource run:\n\n  terraform import %s.<name> %q\n\nwhere `<name>` is the name of this resource in your configuration."
+	return fmt.Errorf(msg, id, resourceName, resourceName, id)
 }
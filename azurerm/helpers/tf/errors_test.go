@@ -0,0 +1,16 @@
+package tf
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestImportAsExistsError(t *testing.T) {
+	id := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1"
+	err := ImportAsExistsError("azurerm_resource_group", id)
+
+	expected := `terraform import azurerm_resource_group.<name> "` + id + `"`
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("Expected the error to contain %q but got %q", expected, err.Error())
+	}
+}
@@ -19,6 +19,9 @@ type ClientOptions struct {
 	TenantID       string
 	PartnerId      string
 
+	// UserAgentSuffix is appended to the User Agent sent with every request
+	UserAgentSuffix string
+
 	GraphAuthorizer           autorest.Authorizer
 	GraphEndpoint             string
 	KeyVaultAuthorizer        autorest.Authorizer
@@ -30,15 +33,23 @@ type ClientOptions struct {
 	SkipProviderReg             bool
 	DisableCorrelationRequestID bool
 	Environment                 azure.Environment
+	Throttling                  ThrottlingOptions
 }
 
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
-	if o.PartnerId != "" {
-		setUserAgent(c, o.PartnerId)
-	}
+	setUserAgent(c, o.PartnerId, o.UserAgentSuffix)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	c.Sender = o.buildSender()
+
+	// autorest retries requests which fail with a retryable status code (e.g. 429 or 503)
+	// using these values, so we only override its defaults when they've been configured
+	if o.Throttling.MaxRetries > 0 {
+		c.RetryAttempts = o.Throttling.MaxRetries
+	}
+	if o.Throttling.RetryBackoff > 0 {
+		c.RetryDuration = o.Throttling.RetryBackoff
+	}
 	c.PollingDuration = o.PollingDuration
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
 	if !o.DisableCorrelationRequestID {
@@ -46,7 +57,18 @@ func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.A
 	}
 }
 
-func setUserAgent(client *autorest.Client, partnerID string) {
+func (o ClientOptions) buildSender() autorest.Sender {
+	// NOTE: autorest's retries wrap this sender, so a request which is backing off
+	// before being retried doesn't hold a slot in the limiter
+	decorators := make([]autorest.SendDecorator, 0)
+	if o.Throttling.MaxConcurrentRequests > 0 {
+		decorators = append(decorators, withConcurrentRequestLimit(requestLimiterForSubscription(o.SubscriptionId, o.Throttling.MaxConcurrentRequests)))
+	}
+
+	return autorest.DecorateSender(sender.BuildSender("AzureRM"), decorators...)
+}
+
+func setUserAgent(client *autorest.Client, partnerID string, suffix string) {
 	tfUserAgent := httpclient.UserAgentString()
 
 	providerUserAgent := fmt.Sprintf("%s terraform-provider-azurerm/%s", tfUserAgent, version.ProviderVersion)
@@ -61,5 +83,9 @@ func setUserAgent(client *autorest.Client, partnerID string) {
 		client.UserAgent = fmt.Sprintf("%s pid-%s", client.UserAgent, partnerID)
 	}
 
+	if suffix != "" {
+		client.UserAgent = fmt.Sprintf("%s %s", client.UserAgent, strings.TrimSpace(suffix))
+	}
+
 	log.Printf("[DEBUG] AzureRM Client User Agent: %s\n", client.UserAgent)
 }
@@ -0,0 +1,94 @@
+package common
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestSetUserAgent(t *testing.T) {
+	testData := []struct {
+		partnerId string
+		suffix    string
+		expected  []string
+	}{
+		{
+			expected: []string{"terraform-provider-azurerm/"},
+		},
+		{
+			partnerId: "11111111-1111-1111-1111-111111111111",
+			expected:  []string{"terraform-provider-azurerm/", "pid-11111111-1111-1111-1111-111111111111"},
+		},
+		{
+			suffix:   " my-pipeline/1.0 ",
+			expected: []string{"terraform-provider-azurerm/", "my-pipeline/1.0"},
+		},
+	}
+
+	for _, v := range testData {
+		client := autorest.NewClientWithUserAgent("")
+		setUserAgent(&client, v.partnerId, v.suffix)
+
+		for _, expected := range v.expected {
+			if !strings.Contains(client.UserAgent, expected) {
+				t.Fatalf("Expected the User Agent %q to contain %q", client.UserAgent, expected)
+			}
+		}
+
+		if v.suffix != "" && !strings.HasSuffix(client.UserAgent, strings.TrimSpace(v.suffix)) {
+			t.Fatalf("Expected the User Agent %q to end with %q", client.UserAgent, strings.TrimSpace(v.suffix))
+		}
+	}
+}
+
+func TestConfigureClientRetries(t *testing.T) {
+	testData := []struct {
+		Name             string
+		Throttling       ThrottlingOptions
+		ExpectedAttempts int
+		ExpectedDuration time.Duration
+	}{
+		{
+			Name:             "Not Configured",
+			Throttling:       ThrottlingOptions{},
+			ExpectedAttempts: autorest.DefaultRetryAttempts,
+			ExpectedDuration: autorest.DefaultRetryDuration,
+		},
+		{
+			Name: "Max Retries",
+			Throttling: ThrottlingOptions{
+				MaxRetries: 10,
+			},
+			ExpectedAttempts: 10,
+			ExpectedDuration: autorest.DefaultRetryDuration,
+		},
+		{
+			Name: "Retry Backoff",
+			Throttling: ThrottlingOptions{
+				RetryBackoff: 5 * time.Second,
+			},
+			ExpectedAttempts: autorest.DefaultRetryAttempts,
+			ExpectedDuration: 5 * time.Second,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		client := autorest.NewClientWithUserAgent("")
+		options := ClientOptions{
+			DisableCorrelationRequestID: true,
+			Throttling:                  v.Throttling,
+		}
+		options.ConfigureClient(&client, nil)
+
+		if client.RetryAttempts != v.ExpectedAttempts {
+			t.Fatalf("Expected %d retry attempts but got %d", v.ExpectedAttempts, client.RetryAttempts)
+		}
+		if client.RetryDuration != v.ExpectedDuration {
+			t.Fatalf("Expected a retry duration of %s but got %s", v.ExpectedDuration, client.RetryDuration)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ThrottlingOptions controls how requests to Azure are retried, and how many
+// requests can be sent to a Subscription concurrently
+type ThrottlingOptions struct {
+	// MaxRetries overrides autorest's RetryAttempts when greater than 0
+	MaxRetries int
+
+	// RetryBackoff overrides autorest's RetryDuration when greater than 0
+	RetryBackoff time.Duration
+
+	// MaxConcurrentRequests limits the number of in-flight requests per Subscription when greater than 0
+	MaxConcurrentRequests int
+}
+
+var (
+	requestLimitersLock sync.Mutex
+	requestLimiters     = map[string]chan struct{}{}
+)
+
+// requestLimiterForSubscription returns the limiter shared by all clients (including those of
+// aliased Provider blocks) talking to the specified Subscription, creating it if necessary
+func requestLimiterForSubscription(subscriptionId string, maxConcurrentRequests int) chan struct{} {
+	requestLimitersLock.Lock()
+	defer requestLimitersLock.Unlock()
+
+	if limiter, ok := requestLimiters[subscriptionId]; ok {
+		return limiter
+	}
+
+	log.Printf("[DEBUG] Limiting requests for Subscription %q to %d concurrent requests", subscriptionId, maxConcurrentRequests)
+	limiter := make(chan struct{}, maxConcurrentRequests)
+	requestLimiters[subscriptionId] = limiter
+	return limiter
+}
+
+// withConcurrentRequestLimit returns a SendDecorator which blocks until a slot is available in the
+// limiter before sending the request, releasing it once the response has been received
+func withConcurrentRequestLimit(limiter chan struct{}) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			select {
+			case limiter <- struct{}{}:
+			case <-r.Context().Done():
+				return nil, r.Context().Err()
+			}
+
+			defer func() {
+				<-limiter
+			}()
+
+			return s.Do(r)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package common
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRequestLimiterForSubscription(t *testing.T) {
+	first := requestLimiterForSubscription("00000000-0000-0000-0000-000000000001", 2)
+	second := requestLimiterForSubscription("00000000-0000-0000-0000-000000000001", 5)
+	if first != second {
+		t.Fatal("expected the same limiter to be returned for the same subscription")
+	}
+	if cap(second) != 2 {
+		t.Fatalf("expected the limiter to allow 2 concurrent requests but got %d", cap(second))
+	}
+
+	other := requestLimiterForSubscription("00000000-0000-0000-0000-000000000002", 5)
+	if first == other {
+		t.Fatal("expected a different limiter to be returned for a different subscription")
+	}
+}
+
+func TestWithConcurrentRequestLimit(t *testing.T) {
+	limit := int32(2)
+	limiter := make(chan struct{}, limit)
+
+	var inFlight, maxInFlight int32
+	base := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		for {
+			previous := atomic.LoadInt32(&maxInFlight)
+			if current <= previous || atomic.CompareAndSwapInt32(&maxInFlight, previous, current) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+		return testThrottlingResponse(r, http.StatusOK), nil
+	})
+	sender := autorest.DecorateSender(base, withConcurrentRequestLimit(limiter))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, "https://management.azure.com/", nil)
+			if _, err := sender.Do(req); err != nil {
+				t.Errorf("unexpected error: %+v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > limit {
+		t.Fatalf("expected at most %d concurrent requests but got %d", limit, maxInFlight)
+	}
+}
+
+func testThrottlingResponse(r *http.Request, statusCode int) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Body:       ioutil.NopCloser(strings.NewReader("")),
+		Request:    r,
+	}
+}
@@ -18,6 +18,7 @@ type Client struct {
 	VMExtensionImageClient         *compute.VirtualMachineExtensionImagesClient
 	VMExtensionClient              *compute.VirtualMachineExtensionsClient
 	VMScaleSetClient               *compute.VirtualMachineScaleSetsClient
+	VMScaleSetExtensionsClient     *compute.VirtualMachineScaleSetExtensionsClient
 	VMClient                       *compute.VirtualMachinesClient
 	VMImageClient                  *compute.VirtualMachineImagesClient
 }
@@ -63,6 +64,9 @@ func BuildClient(o *common.ClientOptions) *Client {
 	VMScaleSetClient := compute.NewVirtualMachineScaleSetsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VMScaleSetClient.Client, o.ResourceManagerAuthorizer)
 
+	VMScaleSetExtensionsClient := compute.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&VMScaleSetExtensionsClient.Client, o.ResourceManagerAuthorizer)
+
 	VMClient := compute.NewVirtualMachinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&VMClient.Client, o.ResourceManagerAuthorizer)
 
@@ -79,6 +83,7 @@ func BuildClient(o *common.ClientOptions) *Client {
 		VMExtensionImageClient:         &VMExtensionImageClient,
 		VMExtensionClient:              &VMExtensionClient,
 		VMScaleSetClient:               &VMScaleSetClient,
+		VMScaleSetExtensionsClient:     &VMScaleSetExtensionsClient,
 		VMClient:                       &VMClient,
 		VMImageClient:                  &VMImageClient,
 	}
@@ -13,6 +13,7 @@ type Client struct {
 	RegistriesClient         *containerregistry.RegistriesClient
 	WebhooksClient           *containerregistry.WebhooksClient
 	ReplicationsClient       *containerregistry.ReplicationsClient
+	TasksClient              *containerregistry.TasksClient
 	ServicesClient           *containerservice.ContainerServicesClient
 }
 
@@ -27,6 +28,9 @@ func BuildClient(o *common.ClientOptions) *Client {
 	ReplicationsClient := containerregistry.NewReplicationsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ReplicationsClient.Client, o.ResourceManagerAuthorizer)
 
+	TasksClient := containerregistry.NewTasksClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&TasksClient.Client, o.ResourceManagerAuthorizer)
+
 	GroupsClient := containerinstance.NewContainerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&GroupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -44,6 +48,7 @@ func BuildClient(o *common.ClientOptions) *Client {
 		RegistriesClient:         &RegistriesClient,
 		WebhooksClient:           &WebhooksClient,
 		ReplicationsClient:       &ReplicationsClient,
+		TasksClient:              &TasksClient,
 		ServicesClient:           &ServicesClient,
 	}
 }
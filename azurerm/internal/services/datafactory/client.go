@@ -6,10 +6,12 @@ import (
 )
 
 type Client struct {
-	DatasetClient       *datafactory.DatasetsClient
-	FactoriesClient     *datafactory.FactoriesClient
-	LinkedServiceClient *datafactory.LinkedServicesClient
-	PipelinesClient     *datafactory.PipelinesClient
+	DatasetClient            *datafactory.DatasetsClient
+	FactoriesClient          *datafactory.FactoriesClient
+	IntegrationRuntimeClient *datafactory.IntegrationRuntimesClient
+	LinkedServiceClient      *datafactory.LinkedServicesClient
+	PipelinesClient          *datafactory.PipelinesClient
+	TriggersClient           *datafactory.TriggersClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -20,16 +22,24 @@ func BuildClient(o *common.ClientOptions) *Client {
 	FactoriesClient := datafactory.NewFactoriesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&FactoriesClient.Client, o.ResourceManagerAuthorizer)
 
+	IntegrationRuntimeClient := datafactory.NewIntegrationRuntimesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&IntegrationRuntimeClient.Client, o.ResourceManagerAuthorizer)
+
 	LinkedServiceClient := datafactory.NewLinkedServicesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&LinkedServiceClient.Client, o.ResourceManagerAuthorizer)
 
 	PipelinesClient := datafactory.NewPipelinesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&PipelinesClient.Client, o.ResourceManagerAuthorizer)
 
+	TriggersClient := datafactory.NewTriggersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&TriggersClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
-		DatasetClient:       &DatasetClient,
-		FactoriesClient:     &FactoriesClient,
-		LinkedServiceClient: &LinkedServiceClient,
-		PipelinesClient:     &PipelinesClient,
+		DatasetClient:            &DatasetClient,
+		FactoriesClient:          &FactoriesClient,
+		IntegrationRuntimeClient: &IntegrationRuntimeClient,
+		LinkedServiceClient:      &LinkedServiceClient,
+		PipelinesClient:          &PipelinesClient,
+		TriggersClient:           &TriggersClient,
 	}
 }
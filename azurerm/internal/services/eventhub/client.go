@@ -6,9 +6,10 @@ import (
 )
 
 type Client struct {
-	ConsumerGroupClient *eventhub.ConsumerGroupsClient
-	EventHubsClient     *eventhub.EventHubsClient
-	NamespacesClient    *eventhub.NamespacesClient
+	ConsumerGroupClient           *eventhub.ConsumerGroupsClient
+	DisasterRecoveryConfigsClient *eventhub.DisasterRecoveryConfigsClient
+	EventHubsClient               *eventhub.EventHubsClient
+	NamespacesClient              *eventhub.NamespacesClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -19,12 +20,16 @@ func BuildClient(o *common.ClientOptions) *Client {
 	ConsumerGroupClient := eventhub.NewConsumerGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ConsumerGroupClient.Client, o.ResourceManagerAuthorizer)
 
+	DisasterRecoveryConfigsClient := eventhub.NewDisasterRecoveryConfigsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DisasterRecoveryConfigsClient.Client, o.ResourceManagerAuthorizer)
+
 	NamespacesClient := eventhub.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NamespacesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ConsumerGroupClient: &ConsumerGroupClient,
-		EventHubsClient:     &EventHubsClient,
-		NamespacesClient:    &NamespacesClient,
+		ConsumerGroupClient:           &ConsumerGroupClient,
+		DisasterRecoveryConfigsClient: &DisasterRecoveryConfigsClient,
+		EventHubsClient:               &EventHubsClient,
+		NamespacesClient:              &NamespacesClient,
 	}
 }
@@ -6,15 +6,20 @@ import (
 )
 
 type Client struct {
-	WorkflowsClient *logic.WorkflowsClient
+	IntegrationAccountsClient *logic.IntegrationAccountsClient
+	WorkflowsClient           *logic.WorkflowsClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
 
+	IntegrationAccountsClient := logic.NewIntegrationAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&IntegrationAccountsClient.Client, o.ResourceManagerAuthorizer)
+
 	WorkflowsClient := logic.NewWorkflowsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&WorkflowsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		WorkflowsClient: &WorkflowsClient,
+		IntegrationAccountsClient: &IntegrationAccountsClient,
+		WorkflowsClient:           &WorkflowsClient,
 	}
 }
@@ -8,15 +8,16 @@ import (
 )
 
 type Client struct {
-	ProtectedItemsClient            *backup.ProtectedItemsGroupClient
-	ProtectionPoliciesClient        *backup.ProtectionPoliciesClient
-	VaultsClient                    *recoveryservices.VaultsClient
-	FabricClient                    func(resourceGroupName string, vaultName string) siterecovery.ReplicationFabricsClient
-	ProtectionContainerClient       func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectionContainersClient
-	ReplicationPoliciesClient       func(resourceGroupName string, vaultName string) siterecovery.ReplicationPoliciesClient
-	ContainerMappingClient          func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectionContainerMappingsClient
-	NetworkMappingClient            func(resourceGroupName string, vaultName string) siterecovery.ReplicationNetworkMappingsClient
-	ReplicationMigrationItemsClient func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectedItemsClient
+	BackupProtectionContainersClient *backup.ProtectionContainersClient
+	ProtectedItemsClient             *backup.ProtectedItemsGroupClient
+	ProtectionPoliciesClient         *backup.ProtectionPoliciesClient
+	VaultsClient                     *recoveryservices.VaultsClient
+	FabricClient                     func(resourceGroupName string, vaultName string) siterecovery.ReplicationFabricsClient
+	ProtectionContainerClient        func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectionContainersClient
+	ReplicationPoliciesClient        func(resourceGroupName string, vaultName string) siterecovery.ReplicationPoliciesClient
+	ContainerMappingClient           func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectionContainerMappingsClient
+	NetworkMappingClient             func(resourceGroupName string, vaultName string) siterecovery.ReplicationNetworkMappingsClient
+	ReplicationMigrationItemsClient  func(resourceGroupName string, vaultName string) siterecovery.ReplicationProtectedItemsClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -27,6 +28,9 @@ func BuildClient(o *common.ClientOptions) *Client {
 	ProtectedItemsClient := backup.NewProtectedItemsGroupClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ProtectedItemsClient.Client, o.ResourceManagerAuthorizer)
 
+	BackupProtectionContainersClient := backup.NewProtectionContainersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&BackupProtectionContainersClient.Client, o.ResourceManagerAuthorizer)
+
 	ProtectionPoliciesClient := backup.NewProtectionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ProtectionPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -67,14 +71,15 @@ func BuildClient(o *common.ClientOptions) *Client {
 	}
 
 	return &Client{
-		ProtectedItemsClient:            &ProtectedItemsClient,
-		ProtectionPoliciesClient:        &ProtectionPoliciesClient,
-		VaultsClient:                    &VaultsClient,
-		FabricClient:                    FabricClient,
-		ProtectionContainerClient:       ProtectionContainerClient,
-		ReplicationPoliciesClient:       ReplicationPoliciesClient,
-		ContainerMappingClient:          ContainerMappingClient,
-		NetworkMappingClient:            NetworkMappingClient,
-		ReplicationMigrationItemsClient: ReplicationMigrationItemsClient,
+		BackupProtectionContainersClient: &BackupProtectionContainersClient,
+		ProtectedItemsClient:             &ProtectedItemsClient,
+		ProtectionPoliciesClient:         &ProtectionPoliciesClient,
+		VaultsClient:                     &VaultsClient,
+		FabricClient:                     FabricClient,
+		ProtectionContainerClient:        ProtectionContainerClient,
+		ReplicationPoliciesClient:        ReplicationPoliciesClient,
+		ContainerMappingClient:           ContainerMappingClient,
+		NetworkMappingClient:             NetworkMappingClient,
+		ReplicationMigrationItemsClient:  ReplicationMigrationItemsClient,
 	}
 }
@@ -6,15 +6,20 @@ import (
 )
 
 type Client struct {
-	NamespacesClient *relay.NamespacesClient
+	HybridConnectionsClient *relay.HybridConnectionsClient
+	NamespacesClient        *relay.NamespacesClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
 
+	HybridConnectionsClient := relay.NewHybridConnectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&HybridConnectionsClient.Client, o.ResourceManagerAuthorizer)
+
 	NamespacesClient := relay.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NamespacesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		NamespacesClient: &NamespacesClient,
+		HybridConnectionsClient: &HybridConnectionsClient,
+		NamespacesClient:        &NamespacesClient,
 	}
 }
@@ -12,6 +12,7 @@ type Client struct {
 	DeploymentsClient *resources.DeploymentsClient
 	LocksClient       *locks.ManagementLocksClient
 	ProvidersClient   *providers.ProvidersClient
+	ResourcesClient   *resources.Client
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -25,6 +26,9 @@ func BuildClient(o *common.ClientOptions) *Client {
 	GroupsClient := resources.NewGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&GroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	ResourcesClient := resources.NewClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&ResourcesClient.Client, o.ResourceManagerAuthorizer)
+
 	// this has to come from the Profile since this is shared with Stack
 	ProvidersClient := providers.NewProvidersClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ProvidersClient.Client, o.ResourceManagerAuthorizer)
@@ -34,5 +38,6 @@ func BuildClient(o *common.ClientOptions) *Client {
 		DeploymentsClient: &DeploymentsClient,
 		LocksClient:       &LocksClient,
 		ProvidersClient:   &ProvidersClient,
+		ResourcesClient:   &ResourcesClient,
 	}
 }
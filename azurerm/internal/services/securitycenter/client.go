@@ -6,6 +6,7 @@ import (
 )
 
 type Client struct {
+	AutoProvisioningClient         *security.AutoProvisioningSettingsClient
 	ContactsClient                 *security.ContactsClient
 	PricingClient                  *security.PricingsClient
 	WorkspaceClient                *security.WorkspaceSettingsClient
@@ -15,6 +16,9 @@ type Client struct {
 func BuildClient(o *common.ClientOptions) *Client {
 	ascLocation := "Global"
 
+	AutoProvisioningClient := security.NewAutoProvisioningSettingsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId, ascLocation)
+	o.ConfigureClient(&AutoProvisioningClient.Client, o.ResourceManagerAuthorizer)
+
 	ContactsClient := security.NewContactsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId, ascLocation)
 	o.ConfigureClient(&ContactsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -28,6 +32,7 @@ func BuildClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&AdvancedThreatProtectionClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
+		AutoProvisioningClient:         &AutoProvisioningClient,
 		ContactsClient:                 &ContactsClient,
 		PricingClient:                  &PricingClient,
 		WorkspaceClient:                &WorkspaceClient,
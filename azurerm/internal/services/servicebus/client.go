@@ -6,11 +6,12 @@ import (
 )
 
 type Client struct {
-	QueuesClient            *servicebus.QueuesClient
-	NamespacesClient        *servicebus.NamespacesClient
-	TopicsClient            *servicebus.TopicsClient
-	SubscriptionsClient     *servicebus.SubscriptionsClient
-	SubscriptionRulesClient *servicebus.RulesClient
+	QueuesClient                  *servicebus.QueuesClient
+	DisasterRecoveryConfigsClient *servicebus.DisasterRecoveryConfigsClient
+	NamespacesClient              *servicebus.NamespacesClient
+	TopicsClient                  *servicebus.TopicsClient
+	SubscriptionsClient           *servicebus.SubscriptionsClient
+	SubscriptionRulesClient       *servicebus.RulesClient
 }
 
 func BuildClient(o *common.ClientOptions) *Client {
@@ -18,6 +19,9 @@ func BuildClient(o *common.ClientOptions) *Client {
 	QueuesClient := servicebus.NewQueuesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&QueuesClient.Client, o.ResourceManagerAuthorizer)
 
+	DisasterRecoveryConfigsClient := servicebus.NewDisasterRecoveryConfigsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&DisasterRecoveryConfigsClient.Client, o.ResourceManagerAuthorizer)
+
 	NamespacesClient := servicebus.NewNamespacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&NamespacesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -31,10 +35,11 @@ func BuildClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&SubscriptionRulesClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		QueuesClient:            &QueuesClient,
-		NamespacesClient:        &NamespacesClient,
-		TopicsClient:            &TopicsClient,
-		SubscriptionsClient:     &SubscriptionsClient,
-		SubscriptionRulesClient: &SubscriptionRulesClient,
+		QueuesClient:                  &QueuesClient,
+		DisasterRecoveryConfigsClient: &DisasterRecoveryConfigsClient,
+		NamespacesClient:              &NamespacesClient,
+		TopicsClient:                  &TopicsClient,
+		SubscriptionsClient:           &SubscriptionsClient,
+		SubscriptionRulesClient:       &SubscriptionRulesClient,
 	}
 }
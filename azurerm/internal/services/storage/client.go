@@ -18,7 +18,8 @@ import (
 )
 
 type Client struct {
-	AccountsClient storage.AccountsClient
+	AccountsClient     storage.AccountsClient
+	BlobServicesClient storage.BlobServicesClient
 
 	environment az.Environment
 }
@@ -27,11 +28,15 @@ func BuildClient(options *common.ClientOptions) *Client {
 	accountsClient := storage.NewAccountsClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&accountsClient.Client, options.ResourceManagerAuthorizer)
 
+	blobServicesClient := storage.NewBlobServicesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
+	options.ConfigureClient(&blobServicesClient.Client, options.ResourceManagerAuthorizer)
+
 	// TODO: switch Storage Containers to using the storage.BlobContainersClient
 	// (which should fix #2977) when the storage clients have been moved in here
 	return &Client{
-		AccountsClient: accountsClient,
-		environment:    options.Environment,
+		AccountsClient:     accountsClient,
+		BlobServicesClient: blobServicesClient,
+		environment:        options.Environment,
 	}
 }
 
@@ -0,0 +1,43 @@
+package timeouts
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// defaultOperationTimeout is used for Create, Update & Delete operations, since some Azure
+	// resources (e.g. API Management or SQL Managed Instances) can take several hours to provision
+	defaultOperationTimeout = 3 * time.Hour
+
+	// defaultReadTimeout is used for Read operations
+	defaultReadTimeout = 5 * time.Minute
+)
+
+// SetDefaults ensures the Data Source/Resource exposes a `timeouts` block, defaulting any
+// operations which don't already have a timeout defined
+func SetDefaults(r *schema.Resource) {
+	if r.Timeouts == nil {
+		r.Timeouts = &schema.ResourceTimeout{}
+	}
+
+	if r.Read != nil && r.Timeouts.Read == nil {
+		r.Timeouts.Read = schema.DefaultTimeout(defaultReadTimeout)
+	}
+
+	// Data Sources only support Read
+	if r.Create == nil {
+		return
+	}
+
+	if r.Timeouts.Create == nil {
+		r.Timeouts.Create = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+	if r.Update != nil && r.Timeouts.Update == nil {
+		r.Timeouts.Update = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+	if r.Delete != nil && r.Timeouts.Delete == nil {
+		r.Timeouts.Delete = schema.DefaultTimeout(defaultOperationTimeout)
+	}
+}
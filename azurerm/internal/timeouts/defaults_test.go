@@ -0,0 +1,48 @@
+package timeouts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestSetDefaults_DataSource(t *testing.T) {
+	r := &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error { return nil },
+	}
+	SetDefaults(r)
+
+	if r.Timeouts == nil || r.Timeouts.Read == nil || *r.Timeouts.Read != defaultReadTimeout {
+		t.Fatalf("expected a Read timeout of %s", defaultReadTimeout)
+	}
+	if r.Timeouts.Create != nil || r.Timeouts.Update != nil || r.Timeouts.Delete != nil {
+		t.Fatal("expected only a Read timeout to be set for a Data Source")
+	}
+}
+
+func TestSetDefaults_Resource(t *testing.T) {
+	noop := func(d *schema.ResourceData, meta interface{}) error { return nil }
+	r := &schema.Resource{
+		Create: noop,
+		Read:   noop,
+		Delete: noop,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(12 * time.Hour),
+		},
+	}
+	SetDefaults(r)
+
+	if *r.Timeouts.Create != 12*time.Hour {
+		t.Fatalf("expected the existing Create timeout to be retained but got %s", *r.Timeouts.Create)
+	}
+	if *r.Timeouts.Read != defaultReadTimeout {
+		t.Fatalf("expected a Read timeout of %s but got %s", defaultReadTimeout, *r.Timeouts.Read)
+	}
+	if *r.Timeouts.Delete != defaultOperationTimeout {
+		t.Fatalf("expected a Delete timeout of %s but got %s", defaultOperationTimeout, *r.Timeouts.Delete)
+	}
+	if r.Timeouts.Update != nil {
+		t.Fatal("expected no Update timeout for a Resource without an Update function")
+	}
+}
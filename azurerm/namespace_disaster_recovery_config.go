@@ -0,0 +1,112 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// namespaceDisasterRecoveryConfigClient wraps the Disaster Recovery Config clients of the EventHub and ServiceBus
+// SDKs - which expose the same API using their own types - so the pairing logic can be shared between both resources
+type namespaceDisasterRecoveryConfigClient struct {
+	// namespaceType is the type of Namespace (e.g. `EventHub`) used in log and error messages
+	namespaceType string
+
+	createOrUpdate    func(ctx context.Context, resourceGroup, namespaceName, name, partnerNamespaceId, alternateName string) error
+	provisioningState func(ctx context.Context, resourceGroup, namespaceName, name string) (autorest.Response, string, error)
+	breakPairing      func(ctx context.Context, resourceGroup, namespaceName, name string) (autorest.Response, error)
+	delete            func(ctx context.Context, resourceGroup, namespaceName, name string) (autorest.Response, error)
+}
+
+func namespaceDisasterRecoveryConfigPair(ctx context.Context, client namespaceDisasterRecoveryConfigClient, resourceGroup, namespaceName, name, partnerNamespaceId, alternateName string) error {
+	if err := client.createOrUpdate(ctx, resourceGroup, namespaceName, name, partnerNamespaceId, alternateName); err != nil {
+		return fmt.Errorf("Error pairing %s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q): %+v", client.namespaceType, name, namespaceName, resourceGroup, err)
+	}
+
+	return waitForNamespaceDisasterRecoveryConfigToProvision(ctx, client, resourceGroup, namespaceName, name)
+}
+
+func namespaceDisasterRecoveryConfigBreakPairing(ctx context.Context, client namespaceDisasterRecoveryConfigClient, resourceGroup, namespaceName, name string) error {
+	if _, err := client.breakPairing(ctx, resourceGroup, namespaceName, name); err != nil {
+		return fmt.Errorf("Error breaking the pairing for %s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q): %+v", client.namespaceType, name, namespaceName, resourceGroup, err)
+	}
+
+	return waitForNamespaceDisasterRecoveryConfigToProvision(ctx, client, resourceGroup, namespaceName, name)
+}
+
+func namespaceDisasterRecoveryConfigDelete(ctx context.Context, client namespaceDisasterRecoveryConfigClient, resourceGroup, namespaceName, name string) error {
+	// the alias can only be deleted once the pairing has been broken
+	breakResp, err := client.breakPairing(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(breakResp) {
+			return nil
+		}
+		return fmt.Errorf("Error breaking the pairing for %s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q): %+v", client.namespaceType, name, namespaceName, resourceGroup, err)
+	}
+
+	if err := waitForNamespaceDisasterRecoveryConfigToProvision(ctx, client, resourceGroup, namespaceName, name); err != nil {
+		return err
+	}
+
+	resp, err := client.delete(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error issuing delete request for %s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q): %+v", client.namespaceType, name, namespaceName, resourceGroup, err)
+		}
+	}
+
+	// the secondary namespace remains locked until the alias has been fully removed
+	log.Printf("[DEBUG] Waiting for %s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q) to be deleted", client.namespaceType, name, namespaceName, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Present"},
+		Target:  []string{"NotFound"},
+		Refresh: func() (interface{}, string, error) {
+			res, _, err := client.provisioningState(ctx, resourceGroup, namespaceName, name)
+			if err != nil {
+				if utils.ResponseWasNotFound(res) {
+					return res, "NotFound", nil
+				}
+				return nil, "", fmt.Errorf("Error polling for the deletion of %s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q): %+v", client.namespaceType, name, namespaceName, resourceGroup, err)
+			}
+
+			return res, "Present", nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q) to be deleted: %+v", client.namespaceType, name, namespaceName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func waitForNamespaceDisasterRecoveryConfigToProvision(ctx context.Context, client namespaceDisasterRecoveryConfigClient, resourceGroup, namespaceName, name string) error {
+	log.Printf("[DEBUG] Waiting for %s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q) to finish provisioning", client.namespaceType, name, namespaceName, resourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Accepted"},
+		Target:  []string{"Succeeded"},
+		Refresh: func() (interface{}, string, error) {
+			res, state, err := client.provisioningState(ctx, resourceGroup, namespaceName, name)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error polling for the status of %s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q): %+v", client.namespaceType, name, namespaceName, resourceGroup, err)
+			}
+
+			if state == "Failed" {
+				return res, state, fmt.Errorf("%s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q) failed to provision", client.namespaceType, name, namespaceName, resourceGroup)
+			}
+
+			return res, state, nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for %s Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q) to finish provisioning: %+v", client.namespaceType, name, namespaceName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -3,12 +3,19 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	intCommon "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 // Provider returns a terraform.ResourceProvider.
@@ -95,6 +102,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_recovery_services_protection_policy_vm": dataSourceArmRecoveryServicesProtectionPolicyVm(),
 		"azurerm_redis_cache":                            dataSourceArmRedisCache(),
 		"azurerm_resource_group":                         dataSourceArmResourceGroup(),
+		"azurerm_resources":                              dataSourceArmResources(),
 		"azurerm_role_definition":                        dataSourceArmRoleDefinition(),
 		"azurerm_route_table":                            dataSourceArmRouteTable(),
 		"azurerm_scheduler_job_collection":               dataSourceArmSchedulerJobCollection(),
@@ -121,6 +129,7 @@ func Provider() terraform.ResourceProvider {
 	}
 
 	resources := map[string]*schema.Resource{
+		"azurerm_advanced_threat_protection":                         resourceArmAdvancedThreatProtection(),
 		"azurerm_analysis_services_server":                           resourceArmAnalysisServicesServer(),
 		"azurerm_api_management":                                     resourceArmApiManagementService(),
 		"azurerm_api_management_api":                                 resourceArmApiManagementApi(),
@@ -140,6 +149,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_api_management_product_api":                         resourceArmApiManagementProductApi(),
 		"azurerm_api_management_product_group":                       resourceArmApiManagementProductGroup(),
 		"azurerm_api_management_product_policy":                      resourceArmApiManagementProductPolicy(),
+		"azurerm_api_management_named_value":                         resourceArmApiManagementNamedValue(),
 		"azurerm_api_management_property":                            resourceArmApiManagementProperty(),
 		"azurerm_api_management_subscription":                        resourceArmApiManagementSubscription(),
 		"azurerm_api_management_user":                                resourceArmApiManagementUser(),
@@ -159,6 +169,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_automation_credential":                              resourceArmAutomationCredential(),
 		"azurerm_automation_dsc_configuration":                       resourceArmAutomationDscConfiguration(),
 		"azurerm_automation_dsc_nodeconfiguration":                   resourceArmAutomationDscNodeConfiguration(),
+		"azurerm_automation_job_schedule":                            resourceArmAutomationJobSchedule(),
 		"azurerm_automation_module":                                  resourceArmAutomationModule(),
 		"azurerm_automation_runbook":                                 resourceArmAutomationRunbook(),
 		"azurerm_automation_schedule":                                resourceArmAutomationSchedule(),
@@ -171,16 +182,21 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_azuread_application":                                resourceArmActiveDirectoryApplication(),
 		"azurerm_azuread_service_principal_password":                 resourceArmActiveDirectoryServicePrincipalPassword(),
 		"azurerm_azuread_service_principal":                          resourceArmActiveDirectoryServicePrincipal(),
+		"azurerm_backup_container_storage_account":                   resourceArmBackupContainerStorageAccount(),
+		"azurerm_backup_policy_file_share":                           resourceArmBackupPolicyFileShare(),
+		"azurerm_backup_protected_file_share":                        resourceArmBackupProtectedFileShare(),
 		"azurerm_batch_account":                                      resourceArmBatchAccount(),
 		"azurerm_batch_application":                                  resourceArmBatchApplication(),
 		"azurerm_batch_certificate":                                  resourceArmBatchCertificate(),
 		"azurerm_bot_channels_registration":                          resourceArmBotChannelsRegistration(),
 		"azurerm_batch_pool":                                         resourceArmBatchPool(),
 		"azurerm_cdn_endpoint":                                       resourceArmCdnEndpoint(),
+		"azurerm_cdn_endpoint_custom_domain":                         resourceArmCdnEndpointCustomDomain(),
 		"azurerm_cdn_profile":                                        resourceArmCdnProfile(),
 		"azurerm_cognitive_account":                                  resourceArmCognitiveAccount(),
 		"azurerm_connection_monitor":                                 resourceArmConnectionMonitor(),
 		"azurerm_container_group":                                    resourceArmContainerGroup(),
+		"azurerm_container_registry_task":                            resourceArmContainerRegistryTask(),
 		"azurerm_container_registry_webhook":                         resourceArmContainerRegistryWebhook(),
 		"azurerm_container_registry":                                 resourceArmContainerRegistry(),
 		"azurerm_container_service":                                  resourceArmContainerService(),
@@ -195,11 +211,15 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_data_factory_dataset_mysql":                         resourceArmDataFactoryDatasetMySQL(),
 		"azurerm_data_factory_dataset_postgresql":                    resourceArmDataFactoryDatasetPostgreSQL(),
 		"azurerm_data_factory_dataset_sql_server_table":              resourceArmDataFactoryDatasetSQLServerTable(),
+		"azurerm_data_factory_integration_runtime_self_hosted":       resourceArmDataFactoryIntegrationRuntimeSelfHosted(),
+		"azurerm_data_factory_linked_service_azure_blob_storage":     resourceArmDataFactoryLinkedServiceAzureBlobStorage(),
 		"azurerm_data_factory_linked_service_data_lake_storage_gen2": resourceArmDataFactoryLinkedServiceDataLakeStorageGen2(),
+		"azurerm_data_factory_linked_service_key_vault":              resourceArmDataFactoryLinkedServiceKeyVault(),
 		"azurerm_data_factory_linked_service_mysql":                  resourceArmDataFactoryLinkedServiceMySQL(),
 		"azurerm_data_factory_linked_service_postgresql":             resourceArmDataFactoryLinkedServicePostgreSQL(),
 		"azurerm_data_factory_linked_service_sql_server":             resourceArmDataFactoryLinkedServiceSQLServer(),
 		"azurerm_data_factory_pipeline":                              resourceArmDataFactoryPipeline(),
+		"azurerm_data_factory_trigger_schedule":                      resourceArmDataFactoryTriggerSchedule(),
 		"azurerm_data_lake_analytics_account":                        resourceArmDataLakeAnalyticsAccount(),
 		"azurerm_data_lake_analytics_firewall_rule":                  resourceArmDataLakeAnalyticsFirewallRule(),
 		"azurerm_data_lake_store_file":                               resourceArmDataLakeStoreFile(),
@@ -230,6 +250,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_eventhub_authorization_rule":                        resourceArmEventHubAuthorizationRule(),
 		"azurerm_eventhub_consumer_group":                            resourceArmEventHubConsumerGroup(),
 		"azurerm_eventhub_namespace_authorization_rule":              resourceArmEventHubNamespaceAuthorizationRule(),
+		"azurerm_eventhub_namespace_disaster_recovery_config":        resourceArmEventHubNamespaceDisasterRecoveryConfig(),
 		"azurerm_eventhub_namespace":                                 resourceArmEventHubNamespace(),
 		"azurerm_eventhub":                                           resourceArmEventHub(),
 		"azurerm_express_route_circuit_authorization":                resourceArmExpressRouteCircuitAuthorization(),
@@ -251,6 +272,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_image":                                              resourceArmImage(),
 		"azurerm_iot_dps":                                            resourceArmIotDPS(),
 		"azurerm_iot_dps_certificate":                                resourceArmIotDPSCertificate(),
+		"azurerm_iot_dps_shared_access_policy":                       resourceArmIotDPSSharedAccessPolicy(),
 		"azurerm_iothub_consumer_group":                              resourceArmIotHubConsumerGroup(),
 		"azurerm_iothub":                                             resourceArmIotHub(),
 		"azurerm_iothub_shared_access_policy":                        resourceArmIotHubSharedAccessPolicy(),
@@ -276,12 +298,14 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_log_analytics_workspace":                            resourceArmLogAnalyticsWorkspace(),
 		"azurerm_logic_app_action_custom":                            resourceArmLogicAppActionCustom(),
 		"azurerm_logic_app_action_http":                              resourceArmLogicAppActionHTTP(),
+		"azurerm_logic_app_integration_account":                      resourceArmLogicAppIntegrationAccount(),
 		"azurerm_logic_app_trigger_custom":                           resourceArmLogicAppTriggerCustom(),
 		"azurerm_logic_app_trigger_http_request":                     resourceArmLogicAppTriggerHttpRequest(),
 		"azurerm_logic_app_trigger_recurrence":                       resourceArmLogicAppTriggerRecurrence(),
 		"azurerm_logic_app_workflow":                                 resourceArmLogicAppWorkflow(),
 		"azurerm_managed_disk":                                       resourceArmManagedDisk(),
 		"azurerm_management_group":                                   resourceArmManagementGroup(),
+		"azurerm_management_group_subscription_association":          resourceArmManagementGroupSubscriptionAssociation(),
 		"azurerm_management_lock":                                    resourceArmManagementLock(),
 		"azurerm_maps_account":                                       resourceArmMapsAccount(),
 		"azurerm_mariadb_configuration":                              resourceArmMariaDbConfiguration(),
@@ -345,8 +369,10 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_recovery_services_vault":                                                resourceArmRecoveryServicesVault(),
 		"azurerm_redis_cache":                                                            resourceArmRedisCache(),
 		"azurerm_redis_firewall_rule":                                                    resourceArmRedisFirewallRule(),
+		"azurerm_relay_hybrid_connection":                                                resourceArmRelayHybridConnection(),
 		"azurerm_relay_namespace":                                                        resourceArmRelayNamespace(),
 		"azurerm_resource_group":                                                         resourceArmResourceGroup(),
+		"azurerm_resource_group_template_deployment":                                     resourceArmResourceGroupTemplateDeployment(),
 		"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
 		"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
 		"azurerm_route_table":                                                            resourceArmRouteTable(),
@@ -354,11 +380,13 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_scheduler_job_collection":                                               resourceArmSchedulerJobCollection(),
 		"azurerm_scheduler_job":                                                          resourceArmSchedulerJob(),
 		"azurerm_search_service":                                                         resourceArmSearchService(),
+		"azurerm_security_center_auto_provisioning":                                      resourceArmSecurityCenterAutoProvisioning(),
 		"azurerm_security_center_contact":                                                resourceArmSecurityCenterContact(),
 		"azurerm_security_center_subscription_pricing":                                   resourceArmSecurityCenterSubscriptionPricing(),
 		"azurerm_security_center_workspace":                                              resourceArmSecurityCenterWorkspace(),
 		"azurerm_service_fabric_cluster":                                                 resourceArmServiceFabricCluster(),
 		"azurerm_servicebus_namespace_authorization_rule":                                resourceArmServiceBusNamespaceAuthorizationRule(),
+		"azurerm_servicebus_namespace_disaster_recovery_config":                          resourceArmServiceBusNamespaceDisasterRecoveryConfig(),
 		"azurerm_servicebus_namespace":                                                   resourceArmServiceBusNamespace(),
 		"azurerm_servicebus_queue_authorization_rule":                                    resourceArmServiceBusQueueAuthorizationRule(),
 		"azurerm_servicebus_queue":                                                       resourceArmServiceBusQueue(),
@@ -379,6 +407,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_sql_server":                                                             resourceArmSqlServer(),
 		"azurerm_sql_virtual_network_rule":                                               resourceArmSqlVirtualNetworkRule(),
 		"azurerm_storage_account":                                                        resourceArmStorageAccount(),
+		"azurerm_storage_account_customer_managed_key":                                   resourceArmStorageAccountCustomerManagedKey(),
 		"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
 		"azurerm_storage_container":                                                      resourceArmStorageContainer(),
 		"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
@@ -389,6 +418,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_stream_analytics_job":                                                   resourceArmStreamAnalyticsJob(),
 		"azurerm_stream_analytics_function_javascript_udf":                               resourceArmStreamAnalyticsFunctionUDF(),
 		"azurerm_stream_analytics_output_blob":                                           resourceArmStreamAnalyticsOutputBlob(),
+		"azurerm_stream_analytics_output_cosmosdb":                                       resourceArmStreamAnalyticsOutputCosmosDB(),
 		"azurerm_stream_analytics_output_mssql":                                          resourceArmStreamAnalyticsOutputSql(),
 		"azurerm_stream_analytics_output_eventhub":                                       resourceArmStreamAnalyticsOutputEventHub(),
 		"azurerm_stream_analytics_output_servicebus_queue":                               resourceArmStreamAnalyticsOutputServiceBusQueue(),
@@ -406,6 +436,7 @@ func Provider() terraform.ResourceProvider {
 		"azurerm_virtual_machine_data_disk_attachment":                                   resourceArmVirtualMachineDataDiskAttachment(),
 		"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
 		"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
+		"azurerm_virtual_machine_scale_set_extension":                                    resourceArmVirtualMachineScaleSetExtension(),
 		"azurerm_virtual_machine":                                                        resourceArmVirtualMachine(),
 		"azurerm_virtual_network_gateway_connection":                                     resourceArmVirtualNetworkGatewayConnection(),
 		"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
@@ -435,6 +466,15 @@ func Provider() terraform.ResourceProvider {
 		}
 	}
 
+	// ensure every Data Source and Resource supports a `timeouts` block, retaining any
+	// timeouts which have been explicitly defined on the Data Source/Resource
+	for _, v := range dataSources {
+		timeouts.SetDefaults(v)
+	}
+	for _, v := range resources {
+		timeouts.SetDefaults(v)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -458,6 +498,16 @@ func Provider() terraform.ResourceProvider {
 				Description: "The Tenant ID which should be used.",
 			},
 
+			"auxiliary_tenant_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "A list of additional Tenant IDs which should be used to authenticate cross-tenant requests, such as referencing Images or Snapshots in another Tenant.",
+			},
+
 			"environment": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -511,13 +561,45 @@ func Provider() terraform.ResourceProvider {
 				Description:  "A GUID/UUID that is registered with Microsoft to facilitate partner resource usage attribution.",
 			},
 
+			"user_agent_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USER_AGENT_SUFFIX", ""),
+				Description: "A custom value which should be appended to the User Agent sent with every request to Azure.",
+			},
+
 			"disable_correlation_request_id": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("DISABLE_CORRELATION_REQUEST_ID", false),
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ARM_DISABLE_CORRELATION_REQUEST_ID", "DISABLE_CORRELATION_REQUEST_ID"}, false),
 				Description: "This will disable the x-ms-correlation-request-id header.",
 			},
 
+			// Throttling
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_RETRIES", nil),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of times a request which failed with a retryable status code should be retried. Defaults to the Azure SDK's default of `3`.",
+			},
+
+			"retry_backoff_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_RETRY_BACKOFF_SECONDS", nil),
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The initial number of seconds to wait before retrying a failed request, which is doubled on each subsequent retry unless Azure returns a `Retry-After` header. Defaults to the Azure SDK's default of `30`.",
+			},
+
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ARM_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of concurrent requests which should be sent to Azure for this Subscription. Defaults to `0`, which means unlimited.",
+			},
+
 			// Advanced feature flags
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
@@ -545,11 +627,23 @@ func Provider() terraform.ResourceProvider {
 
 func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
+		var auxTenants []string
+		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
+			auxTenants = *utils.ExpandStringSlice(v)
+		} else if v := os.Getenv("ARM_AUXILIARY_TENANT_IDS"); v != "" {
+			auxTenants = strings.Split(v, ";")
+		}
+
+		if len(auxTenants) > 3 {
+			return nil, fmt.Errorf("The provider only supports 3 auxiliary tenant IDs")
+		}
+
 		builder := &authentication.Builder{
 			SubscriptionID:     d.Get("subscription_id").(string),
 			ClientID:           d.Get("client_id").(string),
 			ClientSecret:       d.Get("client_secret").(string),
 			TenantID:           d.Get("tenant_id").(string),
+			AuxiliaryTenantIDs: auxTenants,
 			Environment:        d.Get("environment").(string),
 			MsiEndpoint:        d.Get("msi_endpoint").(string),
 			ClientCertPassword: d.Get("client_certificate_password").(string),
@@ -560,6 +654,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			SupportsClientSecretAuth:       true,
 			SupportsManagedServiceIdentity: d.Get("use_msi").(bool),
 			SupportsAzureCliToken:          true,
+			SupportsAuxiliaryTenants:       len(auxTenants) > 0,
 
 			// Doc Links
 			ClientSecretDocsLink: "https://www.terraform.io/docs/providers/azurerm/auth/service_principal_client_secret.html",
@@ -571,10 +666,17 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 		}
 
 		partnerId := d.Get("partner_id").(string)
+		userAgentSuffix := d.Get("user_agent_suffix").(string)
 		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
 		disableCorrelationRequestID := d.Get("disable_correlation_request_id").(bool)
 
-		client, err := getArmClient(config, skipProviderRegistration, partnerId, disableCorrelationRequestID)
+		throttling := intCommon.ThrottlingOptions{
+			MaxRetries:            d.Get("max_retries").(int),
+			RetryBackoff:          time.Duration(d.Get("retry_backoff_seconds").(int)) * time.Second,
+			MaxConcurrentRequests: d.Get("max_concurrent_requests").(int),
+		}
+
+		client, err := getArmClient(config, skipProviderRegistration, partnerId, userAgentSuffix, disableCorrelationRequestID, throttling)
 		if err != nil {
 			return nil, err
 		}
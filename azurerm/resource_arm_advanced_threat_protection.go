@@ -0,0 +1,162 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the Advanced Threat Protection setting is a singleton named `current` beneath the target resource
+const advancedThreatProtectionSettingSuffix = "/providers/Microsoft.Security/advancedThreatProtectionSettings/current"
+
+func resourceArmAdvancedThreatProtection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAdvancedThreatProtectionCreateUpdate,
+		Read:   resourceArmAdvancedThreatProtectionRead,
+		Update: resourceArmAdvancedThreatProtectionCreateUpdate,
+		Delete: resourceArmAdvancedThreatProtectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"target_resource_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceArmAdvancedThreatProtectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	targetResourceId := d.Get("target_resource_id").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, targetResourceId)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Advanced Threat Protection for %q: %+v", targetResourceId, err)
+			}
+		}
+
+		// the setting always exists on supported resources, so only treat it as existing when it's enabled
+		if existing.ID != nil && *existing.ID != "" {
+			if props := existing.AdvancedThreatProtectionProperties; props != nil && props.IsEnabled != nil && *props.IsEnabled {
+				return tf.ImportAsExistsError("azurerm_advanced_threat_protection", *existing.ID)
+			}
+		}
+	}
+
+	setting := security.AdvancedThreatProtectionSetting{
+		AdvancedThreatProtectionProperties: &security.AdvancedThreatProtectionProperties{
+			IsEnabled: utils.Bool(d.Get("enabled").(bool)),
+		},
+	}
+
+	resp, err := client.Create(ctx, targetResourceId, setting)
+	if err != nil {
+		return fmt.Errorf("Error updating Advanced Threat Protection for %q: %+v", targetResourceId, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("Cannot read ID of Advanced Threat Protection for %q", targetResourceId)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmAdvancedThreatProtectionRead(d, meta)
+}
+
+func resourceArmAdvancedThreatProtectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAdvancedThreatProtectionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.targetResourceID)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Advanced Threat Protection for %q was not found - removing from state", id.targetResourceID)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Advanced Threat Protection for %q: %+v", id.targetResourceID, err)
+	}
+
+	d.Set("target_resource_id", id.targetResourceID)
+	if props := resp.AdvancedThreatProtectionProperties; props != nil {
+		d.Set("enabled", props.IsEnabled)
+	}
+
+	return nil
+}
+
+func resourceArmAdvancedThreatProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseAdvancedThreatProtectionId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the setting can't be deleted, so we disable it instead
+	setting := security.AdvancedThreatProtectionSetting{
+		AdvancedThreatProtectionProperties: &security.AdvancedThreatProtectionProperties{
+			IsEnabled: utils.Bool(false),
+		},
+	}
+
+	if _, err := client.Create(ctx, id.targetResourceID, setting); err != nil {
+		return fmt.Errorf("Error disabling Advanced Threat Protection for %q: %+v", id.targetResourceID, err)
+	}
+
+	return nil
+}
+
+type advancedThreatProtectionId struct {
+	targetResourceID string
+}
+
+func parseAdvancedThreatProtectionId(input string) (*advancedThreatProtectionId, error) {
+	if !strings.HasSuffix(input, advancedThreatProtectionSettingSuffix) {
+		return nil, fmt.Errorf("Expected the Advanced Threat Protection ID to be in the format `{targetResourceId}%s` but got %q", advancedThreatProtectionSettingSuffix, input)
+	}
+
+	targetResourceId := strings.TrimSuffix(input, advancedThreatProtectionSettingSuffix)
+	if _, err := azure.ParseAzureResourceID(targetResourceId); err != nil {
+		return nil, fmt.Errorf("Error parsing the Target Resource ID of Advanced Threat Protection %q: %+v", input, err)
+	}
+
+	return &advancedThreatProtectionId{
+		targetResourceID: targetResourceId,
+	}, nil
+}
@@ -0,0 +1,259 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestParseAdvancedThreatProtectionId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *advancedThreatProtectionId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			Expected: nil,
+		},
+		{
+			Input:    "/providers/Microsoft.Security/advancedThreatProtectionSettings/current",
+			Expected: nil,
+		},
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Security/advancedThreatProtectionSettings/other",
+			Expected: nil,
+		},
+		{
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Security/advancedThreatProtectionSettings/current",
+			Expected: &advancedThreatProtectionId{
+				targetResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseAdvancedThreatProtectionId(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.targetResourceID != v.Expected.targetResourceID {
+			t.Fatalf("Expected %q but got %q for targetResourceID", v.Expected.targetResourceID, actual.targetResourceID)
+		}
+	}
+}
+
+func TestAccAzureRMAdvancedThreatProtection_storageAccount(t *testing.T) {
+	resourceName := "azurerm_advanced_threat_protection.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAdvancedThreatProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAdvancedThreatProtection_storageAccount(ri, rs, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAdvancedThreatProtectionIsEnabled(resourceName, true),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMAdvancedThreatProtection_storageAccount(ri, rs, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAdvancedThreatProtectionIsEnabled(resourceName, false),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAdvancedThreatProtection_cosmosAccount(t *testing.T) {
+	resourceName := "azurerm_advanced_threat_protection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAdvancedThreatProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAdvancedThreatProtection_cosmosAccount(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAdvancedThreatProtectionIsEnabled(resourceName, true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAdvancedThreatProtection_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_advanced_threat_protection.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAdvancedThreatProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAdvancedThreatProtection_storageAccount(ri, rs, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAdvancedThreatProtectionIsEnabled(resourceName, true),
+				),
+			},
+			{
+				Config:      testAccAzureRMAdvancedThreatProtection_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_advanced_threat_protection"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAdvancedThreatProtectionIsEnabled(resourceName string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).securityCenter.AdvancedThreatProtectionClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parseAdvancedThreatProtectionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.targetResourceID)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on AdvancedThreatProtectionClient: %+v", err)
+		}
+
+		if props := resp.AdvancedThreatProtectionProperties; props == nil || props.IsEnabled == nil || *props.IsEnabled != expected {
+			return fmt.Errorf("Bad: Advanced Threat Protection for %q was expected to be enabled=%t", id.targetResourceID, expected)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMAdvancedThreatProtectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).securityCenter.AdvancedThreatProtectionClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_advanced_threat_protection" {
+			continue
+		}
+
+		id, err := parseAdvancedThreatProtectionId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.targetResourceID)
+		if err != nil {
+			// the target resource has been removed too
+			return nil
+		}
+
+		if props := resp.AdvancedThreatProtectionProperties; props != nil && props.IsEnabled != nil && *props.IsEnabled {
+			return fmt.Errorf("Advanced Threat Protection for %q is still enabled", id.targetResourceID)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMAdvancedThreatProtection_storageAccount(rInt int, rString string, location string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestatp%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_advanced_threat_protection" "test" {
+  target_resource_id = "${azurerm_storage_account.test.id}"
+  enabled            = %t
+}
+`, rInt, location, rString, enabled)
+}
+
+func testAccAzureRMAdvancedThreatProtection_cosmosAccount(rInt int, location string) string {
+	template := testAccAzureRMCosmosDBAccount_basic(rInt, location, "Eventual", "", "")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_advanced_threat_protection" "test" {
+  target_resource_id = "${azurerm_cosmosdb_account.test.id}"
+  enabled            = true
+}
+`, template)
+}
+
+func testAccAzureRMAdvancedThreatProtection_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMAdvancedThreatProtection_storageAccount(rInt, rString, location, true)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_advanced_threat_protection" "import" {
+  target_resource_id = "${azurerm_advanced_threat_protection.test.target_resource_id}"
+  enabled            = "${azurerm_advanced_threat_protection.test.enabled}"
+}
+`, template)
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -15,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -52,6 +54,14 @@ func resourceArmApiManagementService() *schema.Resource {
 				},
 			},
 
+			"private_ip_addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"publisher_name": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -114,6 +124,33 @@ func resourceArmApiManagementService() *schema.Resource {
 				},
 			},
 
+			"virtual_network_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(apimanagement.VirtualNetworkTypeNone),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.VirtualNetworkTypeNone),
+					string(apimanagement.VirtualNetworkTypeExternal),
+					string(apimanagement.VirtualNetworkTypeInternal),
+				}, false),
+			},
+
+			"virtual_network_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+					},
+				},
+			},
+
 			"notification_sender_email": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -139,6 +176,30 @@ func resourceArmApiManagementService() *schema.Resource {
 							},
 							Computed: true,
 						},
+
+						"private_ip_addresses": {
+							Type: schema.TypeList,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Computed: true,
+						},
+
+						"virtual_network_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"subnet_id": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     azure.ValidateResourceID,
+										DiffSuppressFunc: suppress.CaseDifference,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -169,6 +230,21 @@ func resourceArmApiManagementService() *schema.Resource {
 								string(apimanagement.Root),
 							}, false),
 						},
+
+						"expiry": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subject": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"thumbprint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -376,7 +452,8 @@ func resourceArmApiManagementService() *schema.Resource {
 
 func resourceArmApiManagementServiceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ServiceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for API Management Service creation.")
 
@@ -430,6 +507,16 @@ func resourceArmApiManagementServiceCreateUpdate(d *schema.ResourceData, meta in
 		properties.ServiceProperties.AdditionalLocations = expandAzureRmApiManagementAdditionalLocations(d, sku)
 	}
 
+	virtualNetworkType := d.Get("virtual_network_type").(string)
+	virtualNetworkConfiguration := d.Get("virtual_network_configuration").([]interface{})
+	if virtualNetworkType != string(apimanagement.VirtualNetworkTypeNone) && len(virtualNetworkConfiguration) == 0 {
+		return fmt.Errorf("`virtual_network_configuration` must be specified when `virtual_network_type` is %q", virtualNetworkType)
+	}
+	properties.ServiceProperties.VirtualNetworkType = apimanagement.VirtualNetworkType(virtualNetworkType)
+	if virtualNetworkType != string(apimanagement.VirtualNetworkTypeNone) {
+		properties.ServiceProperties.VirtualNetworkConfiguration = expandAzureRmApiManagementVirtualNetworkConfigurations(virtualNetworkConfiguration)
+	}
+
 	if notificationSenderEmail != "" {
 		properties.ServiceProperties.NotificationSenderEmail = &notificationSenderEmail
 	}
@@ -496,7 +583,8 @@ func resourceArmApiManagementServiceCreateUpdate(d *schema.ResourceData, meta in
 
 func resourceArmApiManagementServiceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ServiceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -559,6 +647,16 @@ func resourceArmApiManagementServiceRead(d *schema.ResourceData, meta interface{
 		d.Set("management_api_url", props.ManagementAPIURL)
 		d.Set("scm_url", props.ScmURL)
 		d.Set("public_ip_addresses", props.PublicIPAddresses)
+		d.Set("private_ip_addresses", props.PrivateIPAddresses)
+		d.Set("virtual_network_type", string(props.VirtualNetworkType))
+
+		if err := d.Set("virtual_network_configuration", flattenApiManagementVirtualNetworkConfiguration(props.VirtualNetworkConfiguration)); err != nil {
+			return fmt.Errorf("Error setting `virtual_network_configuration`: %+v", err)
+		}
+
+		if err := d.Set("certificate", flattenApiManagementCertificates(props.Certificates, d)); err != nil {
+			return fmt.Errorf("Error setting `certificate`: %+v", err)
+		}
 
 		if err := d.Set("security", flattenApiManagementCustomProperties(props.CustomProperties)); err != nil {
 			return fmt.Errorf("Error setting `security`: %+v", err)
@@ -595,7 +693,8 @@ func resourceArmApiManagementServiceRead(d *schema.ResourceData, meta interface{
 
 func resourceArmApiManagementServiceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.ServiceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -664,11 +763,20 @@ func expandApiManagementCommonHostnameConfiguration(input map[string]interface{}
 	keyVaultId := input["key_vault_id"].(string)
 
 	output := apimanagement.HostnameConfiguration{
-		EncodedCertificate:  utils.String(encodedCertificate),
-		CertificatePassword: utils.String(certificatePassword),
-		HostName:            utils.String(hostName),
-		KeyVaultID:          utils.String(keyVaultId),
-		Type:                hostnameType,
+		HostName: utils.String(hostName),
+		Type:     hostnameType,
+	}
+
+	if encodedCertificate != "" {
+		output.EncodedCertificate = utils.String(encodedCertificate)
+	}
+
+	if certificatePassword != "" {
+		output.CertificatePassword = utils.String(certificatePassword)
+	}
+
+	if keyVaultId != "" {
+		output.KeyVaultID = utils.String(keyVaultId)
 	}
 
 	if v, ok := input["negotiate_client_certificate"]; ok {
@@ -777,6 +885,49 @@ func expandAzureRmApiManagementCertificates(d *schema.ResourceData) *[]apimanage
 	return &results
 }
 
+func flattenApiManagementCertificates(input *[]apimanagement.CertificateConfiguration, d *schema.ResourceData) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	// the encoded certificate and its password aren't returned from the API, so we load them from the same index
+	// in the existing state. NOTE: this information won't be available during times like Import, so this is a best-effort.
+	existingCertificates := d.Get("certificate").([]interface{})
+
+	for i, config := range *input {
+		output := map[string]interface{}{
+			"store_name": string(config.StoreName),
+		}
+
+		if info := config.Certificate; info != nil {
+			if info.Expiry != nil {
+				output["expiry"] = info.Expiry.Format(time.RFC3339)
+			}
+
+			if info.Subject != nil {
+				output["subject"] = *info.Subject
+			}
+
+			if info.Thumbprint != nil {
+				output["thumbprint"] = *info.Thumbprint
+			}
+		}
+
+		if i < len(existingCertificates) && existingCertificates[i] != nil {
+			existing := existingCertificates[i].(map[string]interface{})
+			if existing["store_name"] == output["store_name"] {
+				output["encoded_certificate"] = existing["encoded_certificate"]
+				output["certificate_password"] = existing["certificate_password"]
+			}
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
 func expandAzureRmApiManagementAdditionalLocations(d *schema.ResourceData, sku *apimanagement.ServiceSkuProperties) *[]apimanagement.AdditionalLocation {
 	inputLocations := d.Get("additional_location").([]interface{})
 
@@ -791,6 +942,10 @@ func expandAzureRmApiManagementAdditionalLocations(d *schema.ResourceData, sku *
 			Sku:      sku,
 		}
 
+		if d.Get("virtual_network_type").(string) != string(apimanagement.VirtualNetworkTypeNone) {
+			additionalLocation.VirtualNetworkConfiguration = expandAzureRmApiManagementVirtualNetworkConfigurations(config["virtual_network_configuration"].([]interface{}))
+		}
+
 		additionalLocations = append(additionalLocations, additionalLocation)
 	}
 
@@ -814,16 +969,47 @@ func flattenApiManagementAdditionalLocations(input *[]apimanagement.AdditionalLo
 			output["public_ip_addresses"] = *prop.PublicIPAddresses
 		}
 
+		if prop.PrivateIPAddresses != nil {
+			output["private_ip_addresses"] = *prop.PrivateIPAddresses
+		}
+
 		if prop.GatewayRegionalURL != nil {
 			output["gateway_regional_url"] = *prop.GatewayRegionalURL
 		}
 
+		output["virtual_network_configuration"] = flattenApiManagementVirtualNetworkConfiguration(prop.VirtualNetworkConfiguration)
+
 		results = append(results, output)
 	}
 
 	return results
 }
 
+func expandAzureRmApiManagementVirtualNetworkConfigurations(input []interface{}) *apimanagement.VirtualNetworkConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	subnetId := v["subnet_id"].(string)
+
+	return &apimanagement.VirtualNetworkConfiguration{
+		SubnetResourceID: utils.String(subnetId),
+	}
+}
+
+func flattenApiManagementVirtualNetworkConfiguration(input *apimanagement.VirtualNetworkConfiguration) []interface{} {
+	if input == nil || input.SubnetResourceID == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"subnet_id": *input.SubnetResourceID,
+		},
+	}
+}
+
 func expandAzureRmApiManagementIdentity(d *schema.ResourceData) *apimanagement.ServiceIdentity {
 	vs := d.Get("identity").([]interface{})
 	if len(vs) == 0 {
@@ -956,7 +1142,7 @@ func apiManagementResourceHostnameSchema(schemaName string) map[string]*schema.S
 		"key_vault_id": {
 			Type:         schema.TypeString,
 			Optional:     true,
-			ValidateFunc: azure.ValidateKeyVaultChildId,
+			ValidateFunc: azure.ValidateKeyVaultChildIdVersionOptional,
 			ConflictsWith: []string{
 				fmt.Sprintf("hostname_configuration.0.%s.0.certificate", schemaName),
 				fmt.Sprintf("hostname_configuration.0.%s.0.certificate_password", schemaName),
@@ -0,0 +1,180 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/apimanagement/mgmt/2018-01-01/apimanagement"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmApiManagementNamedValue() *schema.Resource {
+	return resourceArmApiManagementNamedValueResource("azurerm_api_management_named_value")
+}
+
+// resourceArmApiManagementNamedValueResource returns the implementation shared between the
+// `azurerm_api_management_named_value` resource and the deprecated `azurerm_api_management_property`
+// resource, which manage the same API object
+func resourceArmApiManagementNamedValueResource(resourceName string) *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmApiManagementNamedValueCreateUpdate(resourceName),
+		Read:   resourceArmApiManagementNamedValueRead,
+		Update: resourceArmApiManagementNamedValueCreateUpdate(resourceName),
+		Delete: resourceArmApiManagementNamedValueDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": azure.SchemaApiManagementChildName(),
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"api_management_name": azure.SchemaApiManagementName(),
+
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"value": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"secret": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmApiManagementNamedValueCreateUpdate(resourceName string) func(d *schema.ResourceData, meta interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*ArmClient).apiManagement.PropertyClient
+		ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+		defer cancel()
+
+		name := d.Get("name").(string)
+		resourceGroup := d.Get("resource_group_name").(string)
+		serviceName := d.Get("api_management_name").(string)
+
+		if features.ShouldResourcesBeImported() && d.IsNewResource() {
+			existing, err := client.Get(ctx, resourceGroup, serviceName, name)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("Error checking for presence of existing Named Value %q (API Management Service %q / Resource Group %q): %s", name, serviceName, resourceGroup, err)
+				}
+			}
+
+			if existing.ID != nil && *existing.ID != "" {
+				return tf.ImportAsExistsError(resourceName, *existing.ID)
+			}
+		}
+
+		parameters := apimanagement.PropertyContract{
+			PropertyContractProperties: &apimanagement.PropertyContractProperties{
+				DisplayName: utils.String(d.Get("display_name").(string)),
+				Secret:      utils.Bool(d.Get("secret").(bool)),
+				Value:       utils.String(d.Get("value").(string)),
+			},
+		}
+
+		if tags, ok := d.GetOk("tags"); ok {
+			parameters.PropertyContractProperties.Tags = utils.ExpandStringSlice(tags.([]interface{}))
+		}
+
+		if _, err := client.CreateOrUpdate(ctx, resourceGroup, serviceName, name, parameters, ""); err != nil {
+			return fmt.Errorf("Error creating or updating Named Value %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+		}
+
+		resp, err := client.Get(ctx, resourceGroup, serviceName, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Named Value %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+		}
+		if resp.ID == nil {
+			return fmt.Errorf("Cannot read ID for Named Value %q (Resource Group %q / API Management Service %q)", name, resourceGroup, serviceName)
+		}
+		d.SetId(*resp.ID)
+
+		return resourceArmApiManagementNamedValueRead(d, meta)
+	}
+}
+
+func resourceArmApiManagementNamedValueRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagement.PropertyClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	name := id.Path["properties"]
+
+	resp, err := client.Get(ctx, resourceGroup, serviceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Named Value %q (Resource Group %q / API Management Service %q) was not found - removing from state!", name, resourceGroup, serviceName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request for Named Value %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("api_management_name", serviceName)
+
+	if properties := resp.PropertyContractProperties; properties != nil {
+		d.Set("display_name", properties.DisplayName)
+		d.Set("secret", properties.Secret)
+		d.Set("value", properties.Value)
+		d.Set("tags", properties.Tags)
+	}
+
+	return nil
+}
+
+func resourceArmApiManagementNamedValueDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).apiManagement.PropertyClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	serviceName := id.Path["service"]
+	name := id.Path["properties"]
+
+	if resp, err := client.Delete(ctx, resourceGroup, serviceName, name, ""); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Named Value %q (Resource Group %q / API Management Service %q): %+v", name, resourceGroup, serviceName, err)
+		}
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -75,13 +76,24 @@ func resourceArmApiManagementSubscription() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			// changing any value in this map regenerates both the primary and secondary keys
+			"rotate_when_changed": {
+				Type:          schema.TypeMap,
+				Optional:      true,
+				ConflictsWith: []string{"primary_key", "secondary_key"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
 
 func resourceArmApiManagementSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.SubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resourceGroup := d.Get("resource_group_name").(string)
 	serviceName := d.Get("api_management_name").(string)
@@ -138,12 +150,25 @@ func resourceArmApiManagementSubscriptionCreateUpdate(d *schema.ResourceData, me
 
 	d.SetId(*resp.ID)
 
+	if !d.IsNewResource() && d.HasChange("rotate_when_changed") {
+		log.Printf("[DEBUG] Regenerating the Primary Key for Subscription %q (API Management Service %q / Resource Group %q)", subscriptionId, serviceName, resourceGroup)
+		if _, err := client.RegeneratePrimaryKey(ctx, resourceGroup, serviceName, subscriptionId); err != nil {
+			return fmt.Errorf("Error regenerating the Primary Key for Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+		}
+
+		log.Printf("[DEBUG] Regenerating the Secondary Key for Subscription %q (API Management Service %q / Resource Group %q)", subscriptionId, serviceName, resourceGroup)
+		if _, err := client.RegenerateSecondaryKey(ctx, resourceGroup, serviceName, subscriptionId); err != nil {
+			return fmt.Errorf("Error regenerating the Secondary Key for Subscription %q (API Management Service %q / Resource Group %q): %+v", subscriptionId, serviceName, resourceGroup, err)
+		}
+	}
+
 	return resourceArmApiManagementSubscriptionRead(d, meta)
 }
 
 func resourceArmApiManagementSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.SubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -182,7 +207,8 @@ func resourceArmApiManagementSubscriptionRead(d *schema.ResourceData, meta inter
 
 func resourceArmApiManagementSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).apiManagement.SubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -145,6 +145,49 @@ func TestAccAzureRMAPIManagementSubscription_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAPIManagementSubscription_rotateKeys(t *testing.T) {
+	resourceName := "azurerm_api_management_subscription.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	var primaryKey, secondaryKey string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAPIManagementSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAPIManagementSubscription_rotateKeys(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAPIManagementSubscriptionExists(resourceName),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						primaryKey = attrs["primary_key"]
+						secondaryKey = attrs["secondary_key"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAzureRMAPIManagementSubscription_rotateKeys(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAPIManagementSubscriptionExists(resourceName),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						if attrs["primary_key"] == primaryKey {
+							return fmt.Errorf("Expected the `primary_key` to have been regenerated")
+						}
+						if attrs["secondary_key"] == secondaryKey {
+							return fmt.Errorf("Expected the `secondary_key` to have been regenerated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMAPIManagementSubscriptionDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).apiManagement.SubscriptionsClient
 	for _, rs := range s.RootModule().Resources {
@@ -255,6 +298,26 @@ resource "azurerm_api_management_subscription" "test" {
 `, template)
 }
 
+func testAccAzureRMAPIManagementSubscription_rotateKeys(rInt int, location string, rotation string) string {
+	template := testAccAzureRMAPIManagementSubscription_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_subscription" "test" {
+  resource_group_name = "${azurerm_api_management.test.resource_group_name}"
+  api_management_name = "${azurerm_api_management.test.name}"
+  user_id             = "${azurerm_api_management_user.test.id}"
+  product_id          = "${azurerm_api_management_product.test.id}"
+  display_name        = "Butter Parser API Enterprise Edition"
+  state               = "active"
+
+  rotate_when_changed = {
+    rotation = "%s"
+  }
+}
+`, template, rotation)
+}
+
 func testAccAzureRMAPIManagementSubscription_template(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
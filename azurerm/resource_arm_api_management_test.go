@@ -113,7 +113,10 @@ func TestAccAzureRMApiManagement_complete(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
-					"certificate", // not returned from API, sensitive
+					"certificate.0.encoded_certificate",                      // not returned from API, sensitive
+					"certificate.0.certificate_password",                     // not returned from API, sensitive
+					"certificate.1.encoded_certificate",                      // not returned from API, sensitive
+					"certificate.1.certificate_password",                     // not returned from API, sensitive
 					"hostname_configuration.0.portal.0.certificate",          // not returned from API, sensitive
 					"hostname_configuration.0.portal.0.certificate_password", // not returned from API, sensitive
 					"hostname_configuration.0.proxy.0.certificate",           // not returned from API, sensitive
@@ -199,6 +202,33 @@ func TestAccAzureRMApiManagement_policy(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMApiManagement_virtualNetworkInternal(t *testing.T) {
+	resourceName := "azurerm_api_management.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMApiManagement_virtualNetworkInternal(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApiManagementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApiManagementExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "virtual_network_type", "Internal"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_ip_addresses.#"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMApiManagementDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).apiManagement.ServiceClient
 
@@ -527,3 +557,45 @@ resource "azurerm_api_management" "test" {
 }
 `, rInt, location, rInt, altLocation, rInt, altLocation2, rInt)
 }
+
+func testAccAzureRMApiManagement_virtualNetworkInternal(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestVNET-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestSNET-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku {
+    name     = "Developer"
+    capacity = 1
+  }
+
+  virtual_network_type = "Internal"
+
+  virtual_network_configuration {
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -95,6 +95,33 @@ func TestAccAzureRMAppServiceCertificate_KeyVault(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMAppServiceCertificate_KeyVaultAppServicePlan(t *testing.T) {
+	resourceName := "azurerm_app_service_certificate.plan"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppServiceCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAppServiceCertificateKeyVaultAppServicePlan(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "thumbprint", "7B985BF42467791F23E52B364A3E8DEBAB9C606E"),
+					resource.TestCheckResourceAttrSet(resourceName, "app_service_plan_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"key_vault_secret_id"},
+			},
+		},
+	})
+}
+
 func testAccAzureRMAppServiceCertificatePfx(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -201,6 +228,37 @@ resource "azurerm_app_service_certificate" "test" {
 `, rInt, location, rInt, rInt, rInt)
 }
 
+func testAccAzureRMAppServiceCertificateKeyVaultAppServicePlan(rInt int, location string) string {
+	template := testAccAzureRMAppServiceCertificateKeyVault(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group" "plan" {
+  name     = "acctestwebcertplan%d"
+  location = "%s"
+}
+
+resource "azurerm_app_service_plan" "test" {
+  name                = "acctestASP-%d"
+  location            = azurerm_resource_group.plan.location
+  resource_group_name = azurerm_resource_group.plan.name
+
+  sku {
+    tier = "Standard"
+    size = "S1"
+  }
+}
+
+resource "azurerm_app_service_certificate" "plan" {
+  name                = "acctestplan%d"
+  resource_group_name = azurerm_resource_group.plan.name
+  location            = azurerm_resource_group.plan.location
+  key_vault_secret_id = azurerm_key_vault_certificate.test.id
+  app_service_plan_id = azurerm_app_service_plan.test.id
+}
+`, template, rInt, location, rInt, rInt)
+}
+
 func testCheckAzureRMAppServiceCertificateDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).web.CertificatesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
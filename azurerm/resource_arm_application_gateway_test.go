@@ -626,6 +626,11 @@ func TestAccAzureRMApplicationGateway_webApplicationFirewall(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "waf_configuration.0.max_request_body_size_kb", "100"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -705,6 +710,11 @@ func TestAccAzureRMApplicationGateway_webApplicationFirewall_disabledRuleGroups(
 					resource.TestCheckResourceAttr(resourceName, "waf_configuration.0.disabled_rule_group.2.rule_group_name", "REQUEST-942-APPLICATION-ATTACK-SQLI"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAzureRMApplicationGateway_webApplicationFirewall_disabledRuleGroups_enabled_some_rules(ri, testLocation()),
 				Check: resource.ComposeTestCheckFunc(
@@ -770,6 +780,11 @@ func TestAccAzureRMApplicationGateway_webApplicationFirewall_exclusions(t *testi
 					resource.TestCheckResourceAttr(resourceName, "waf_configuration.0.exclusion.4.selector", ""),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccAzureRMApplicationGateway_webApplicationFirewall_exclusions_one(ri, testLocation()),
 				Check: resource.ComposeTestCheckFunc(
@@ -809,6 +824,11 @@ func TestAccAzureRMApplicationGateway_sslPolicy_policyType_predefined(t *testing
 					resource.TestCheckResourceAttr(resourceName, "ssl_policy.0.policy_name", "AppGwSslPolicy20170401S"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -833,6 +853,11 @@ func TestAccAzureRMApplicationGateway_sslPolicy_policyType_custom(t *testing.T)
 					resource.TestCheckResourceAttr(resourceName, "ssl_policy.0.cipher_suites.2", "TLS_RSA_WITH_AES_128_GCM_SHA256"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -853,6 +878,11 @@ func TestAccAzureRMApplicationGateway_sslPolicy_disabledProtocols(t *testing.T)
 					resource.TestCheckResourceAttr(resourceName, "ssl_policy.0.disabled_protocols.1", "TLSv1_1"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -874,6 +904,11 @@ func TestAccAzureRMApplicationGateway_disabledSslProtocols(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "disabled_ssl_protocols.1", "TLSv1_1"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -967,6 +1002,11 @@ func TestAccAzureRMApplicationGateway_UserAssignedIdentity(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "identity.0.identity_ids.#", "1"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
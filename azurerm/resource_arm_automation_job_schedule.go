@@ -0,0 +1,286 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/automation/mgmt/2015-10-31/automation"
+	"github.com/hashicorp/terraform/helper/schema"
+	uuid "github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmAutomationJobSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmAutomationJobScheduleCreate,
+		Read:   resourceArmAutomationJobScheduleRead,
+		Delete: resourceArmAutomationJobScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"automation_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"runbook_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"schedule_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			// the API lower-cases the keys of the parameters, so these are required to be lower-case
+			"parameters": {
+				Type:         schema.TypeMap,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAutomationJobScheduleParameters,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"run_on": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"job_schedule_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func resourceArmAutomationJobScheduleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automation.JobScheduleClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for AzureRM Automation Job Schedule creation.")
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("automation_account_name").(string)
+	runbookName := d.Get("runbook_name").(string)
+	scheduleName := d.Get("schedule_name").(string)
+
+	jobScheduleId := uuid.NewV4()
+	if v, ok := d.GetOk("job_schedule_id"); ok {
+		id, err := uuid.FromString(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing `job_schedule_id` %q: %+v", v.(string), err)
+		}
+		jobScheduleId = id
+	}
+
+	if features.ShouldResourcesBeImported() {
+		// a Runbook can only be linked to a Schedule once, so check for an existing link rather than the (generated) ID
+		existing, err := findAutomationJobSchedule(meta, resourceGroup, accountName, runbookName, scheduleName)
+		if err != nil {
+			return err
+		}
+
+		if existing != nil && existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_automation_job_schedule", *existing.ID)
+		}
+	}
+
+	parameters := automation.JobScheduleCreateParameters{
+		JobScheduleCreateProperties: &automation.JobScheduleCreateProperties{
+			Schedule: &automation.ScheduleAssociationProperty{
+				Name: utils.String(scheduleName),
+			},
+			Runbook: &automation.RunbookAssociationProperty{
+				Name: utils.String(runbookName),
+			},
+			Parameters: expandAutomationJobScheduleParameters(d.Get("parameters").(map[string]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("run_on"); ok {
+		parameters.JobScheduleCreateProperties.RunOn = utils.String(v.(string))
+	}
+
+	if _, err := client.Create(ctx, resourceGroup, accountName, jobScheduleId, parameters); err != nil {
+		return fmt.Errorf("Error creating Automation Job Schedule %q (Account %q / Resource Group %q): %+v", jobScheduleId.String(), accountName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, accountName, jobScheduleId)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Automation Job Schedule %q (Account %q / Resource Group %q): %+v", jobScheduleId.String(), accountName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Automation Job Schedule %q (Account %q / Resource Group %q) ID", jobScheduleId.String(), accountName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmAutomationJobScheduleRead(d, meta)
+}
+
+func resourceArmAutomationJobScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automation.JobScheduleClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+	jobScheduleId, err := uuid.FromString(id.Path["jobSchedules"])
+	if err != nil {
+		return fmt.Errorf("Error parsing Job Schedule ID %q: %+v", id.Path["jobSchedules"], err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, accountName, jobScheduleId)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Automation Job Schedule %q was not found in Account %q / Resource Group %q - removing from state!", jobScheduleId.String(), accountName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Automation Job Schedule %q (Account %q / Resource Group %q): %+v", jobScheduleId.String(), accountName, resourceGroup, err)
+	}
+
+	d.Set("job_schedule_id", jobScheduleId.String())
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("automation_account_name", accountName)
+
+	if props := resp.JobScheduleProperties; props != nil {
+		if runbook := props.Runbook; runbook != nil {
+			d.Set("runbook_name", runbook.Name)
+		}
+		if schedule := props.Schedule; schedule != nil {
+			d.Set("schedule_name", schedule.Name)
+		}
+		d.Set("run_on", props.RunOn)
+
+		if err := d.Set("parameters", flattenAutomationJobScheduleParameters(props.Parameters)); err != nil {
+			return fmt.Errorf("Error setting `parameters`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmAutomationJobScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).automation.JobScheduleClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	accountName := id.Path["automationAccounts"]
+	jobScheduleId, err := uuid.FromString(id.Path["jobSchedules"])
+	if err != nil {
+		return fmt.Errorf("Error parsing Job Schedule ID %q: %+v", id.Path["jobSchedules"], err)
+	}
+
+	resp, err := client.Delete(ctx, resourceGroup, accountName, jobScheduleId)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Automation Job Schedule %q (Account %q / Resource Group %q): %+v", jobScheduleId.String(), accountName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func findAutomationJobSchedule(meta interface{}, resourceGroup, accountName, runbookName, scheduleName string) (*automation.JobSchedule, error) {
+	client := meta.(*ArmClient).automation.JobScheduleClient
+	ctx := meta.(*ArmClient).StopContext
+
+	filter := fmt.Sprintf("properties/runbook/name eq '%s'", escapeODataFilterValue(runbookName))
+	iterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName, filter)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Automation Job Schedules for Runbook %q (Account %q / Resource Group %q): %+v", runbookName, accountName, resourceGroup, err)
+	}
+
+	for iterator.NotDone() {
+		jobSchedule := iterator.Value()
+		if props := jobSchedule.JobScheduleProperties; props != nil {
+			if props.Schedule != nil && props.Schedule.Name != nil && strings.EqualFold(*props.Schedule.Name, scheduleName) {
+				if props.Runbook != nil && props.Runbook.Name != nil && strings.EqualFold(*props.Runbook.Name, runbookName) {
+					return &jobSchedule, nil
+				}
+			}
+		}
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, fmt.Errorf("Error listing Automation Job Schedules for Runbook %q (Account %q / Resource Group %q): %+v", runbookName, accountName, resourceGroup, err)
+		}
+	}
+
+	return nil, nil
+}
+
+func validateAutomationJobScheduleParameters(v interface{}, k string) (warnings []string, errors []error) {
+	parameters, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for key := range parameters {
+		if key != strings.ToLower(key) {
+			errors = append(errors, fmt.Errorf("%q must only contain lower-case keys but got %q", k, key))
+		}
+	}
+
+	return
+}
+
+func expandAutomationJobScheduleParameters(input map[string]interface{}) map[string]*string {
+	output := make(map[string]*string)
+
+	for k, v := range input {
+		output[k] = utils.String(v.(string))
+	}
+
+	return output
+}
+
+func flattenAutomationJobScheduleParameters(input map[string]*string) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	for k, v := range input {
+		if v != nil {
+			output[k] = *v
+		}
+	}
+
+	return output
+}
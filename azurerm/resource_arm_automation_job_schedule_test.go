@@ -0,0 +1,263 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	uuid "github.com/satori/go.uuid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMAutomationJobSchedule_basic(t *testing.T) {
+	resourceName := "azurerm_automation_job_schedule.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationJobSchedule_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationJobScheduleExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "job_schedule_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationJobSchedule_complete(t *testing.T) {
+	resourceName := "azurerm_automation_job_schedule.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationJobSchedule_complete(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationJobScheduleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.output", "Earth"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.case", "MATTERS"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMAutomationJobSchedule_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_automation_job_schedule.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAutomationJobScheduleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMAutomationJobSchedule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMAutomationJobScheduleExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMAutomationJobSchedule_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_automation_job_schedule"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMAutomationJobScheduleDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).automation.JobScheduleClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_automation_job_schedule" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		accName := id.Path["automationAccounts"]
+		jobScheduleId, err := uuid.FromString(id.Path["jobSchedules"])
+		if err != nil {
+			return fmt.Errorf("Error parsing Job Schedule ID %q: %+v", id.Path["jobSchedules"], err)
+		}
+
+		resp, err := conn.Get(ctx, id.ResourceGroup, accName, jobScheduleId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Automation Job Schedule still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMAutomationJobScheduleExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		accName := id.Path["automationAccounts"]
+		jobScheduleId, err := uuid.FromString(id.Path["jobSchedules"])
+		if err != nil {
+			return fmt.Errorf("Error parsing Job Schedule ID %q: %+v", id.Path["jobSchedules"], err)
+		}
+
+		conn := testAccProvider.Meta().(*ArmClient).automation.JobScheduleClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := conn.Get(ctx, id.ResourceGroup, accName, jobScheduleId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Automation Job Schedule %q (Account %q / Resource Group %q) does not exist", jobScheduleId.String(), accName, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on automationJobScheduleClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMAutomationJobSchedule_prerequisites(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_automation_account" "test" {
+  name                = "acctest-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Basic"
+  }
+}
+
+resource "azurerm_automation_runbook" "test" {
+  name                = "Output-HelloWorld"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  account_name = "${azurerm_automation_account.test.name}"
+  log_verbose  = "true"
+  log_progress = "true"
+  description  = "This is a test runbook for terraform acceptance test"
+  runbook_type = "PowerShell"
+
+  publish_content_link {
+    uri = "https://raw.githubusercontent.com/Azure/azure-quickstart-templates/master/101-automation-runbook-getvms/Runbooks/Get-AzureVMTutorial.ps1"
+  }
+
+  content = <<CONTENT
+param(
+  [string]$Output = "World",
+
+  [string]$Case = "Original"
+)
+"Hello, " + $Output + "!"
+CONTENT
+}
+
+resource "azurerm_automation_schedule" "test" {
+  name                    = "acctestAS-%d"
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+  frequency               = "Hour"
+  interval                = 1
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMAutomationJobSchedule_basic(rInt int, location string) string {
+	template := testAccAzureRMAutomationJobSchedule_prerequisites(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_job_schedule" "test" {
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+  schedule_name           = "${azurerm_automation_schedule.test.name}"
+  runbook_name            = "${azurerm_automation_runbook.test.name}"
+}
+`, template)
+}
+
+func testAccAzureRMAutomationJobSchedule_complete(rInt int, location string) string {
+	template := testAccAzureRMAutomationJobSchedule_prerequisites(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_job_schedule" "test" {
+  resource_group_name     = "${azurerm_resource_group.test.name}"
+  automation_account_name = "${azurerm_automation_account.test.name}"
+  schedule_name           = "${azurerm_automation_schedule.test.name}"
+  runbook_name            = "${azurerm_automation_runbook.test.name}"
+
+  parameters = {
+    output = "Earth"
+    case   = "MATTERS"
+  }
+}
+`, template)
+}
+
+func testAccAzureRMAutomationJobSchedule_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMAutomationJobSchedule_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_job_schedule" "import" {
+  resource_group_name     = "${azurerm_automation_job_schedule.test.resource_group_name}"
+  automation_account_name = "${azurerm_automation_job_schedule.test.automation_account_name}"
+  schedule_name           = "${azurerm_automation_job_schedule.test.schedule_name}"
+  runbook_name            = "${azurerm_automation_job_schedule.test.runbook_name}"
+  job_schedule_id         = "${azurerm_automation_job_schedule.test.job_schedule_id}"
+}
+`, template)
+}
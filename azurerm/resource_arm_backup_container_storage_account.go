@@ -0,0 +1,219 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBackupContainerStorageAccount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupContainerStorageAccountCreate,
+		Read:   resourceArmBackupContainerStorageAccountRead,
+		Delete: resourceArmBackupContainerStorageAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRecoveryServicesVaultName,
+			},
+
+			"storage_account_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+		},
+	}
+}
+
+func resourceArmBackupContainerStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServices.BackupProtectionContainersClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	storageAccountId := d.Get("storage_account_id").(string)
+
+	parsedStorageAccountId, err := azure.ParseAzureResourceID(storageAccountId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `storage_account_id` %q: %+v", storageAccountId, err)
+	}
+	accountName, hasName := parsedStorageAccountId.Path["storageAccounts"]
+	if !hasName {
+		return fmt.Errorf("Error parsing `storage_account_id` %q: doesn't contain `storageAccounts`", storageAccountId)
+	}
+
+	containerName := fmt.Sprintf("StorageContainer;storage;%s;%s", parsedStorageAccountId.ResourceGroup, accountName)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Backup Protection Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_backup_container_storage_account", *existing.ID)
+		}
+	}
+
+	parameters := backup.ProtectionContainerResource{
+		Properties: &backup.AzureStorageContainer{
+			SourceResourceID:     utils.String(storageAccountId),
+			FriendlyName:         utils.String(accountName),
+			BackupManagementType: backup.ManagementTypeAzureStorage,
+			ContainerType:        backup.ContainerTypeStorageContainer1,
+		},
+	}
+
+	if _, err := client.Register(ctx, vaultName, resourceGroup, "Azure", containerName, parameters); err != nil {
+		return fmt.Errorf("Error registering Backup Protection Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	// registration completes asynchronously, so poll until the Storage Account shows as registered
+	resp, err := resourceArmBackupContainerStorageAccountWaitForState(ctx, client, true, vaultName, resourceGroup, containerName)
+	if err != nil {
+		return err
+	}
+
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmBackupContainerStorageAccountRead(d, meta)
+}
+
+func resourceArmBackupContainerStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServices.BackupProtectionContainersClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	fabricName := id.Path["backupFabrics"]
+	containerName := id.Path["protectionContainers"]
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, fabricName, containerName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Backup Protection Container %q was not found in Vault %q / Resource Group %q - removing from state!", containerName, vaultName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Backup Protection Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties := resp.Properties; properties != nil {
+		if container, ok := properties.AsAzureStorageContainer(); ok && container != nil {
+			d.Set("storage_account_id", container.SourceResourceID)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBackupContainerStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServices.BackupProtectionContainersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	fabricName := id.Path["backupFabrics"]
+	containerName := id.Path["protectionContainers"]
+
+	resp, err := client.Unregister(ctx, vaultName, resourceGroup, fabricName, containerName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error unregistering Backup Protection Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+		}
+	}
+
+	if _, err := resourceArmBackupContainerStorageAccountWaitForState(ctx, client, false, vaultName, resourceGroup, containerName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceArmBackupContainerStorageAccountWaitForState(ctx context.Context, client *backup.ProtectionContainersClient, registered bool, vaultName, resourceGroup, containerName string) (backup.ProtectionContainerResource, error) {
+	state := &resource.StateChangeConf{
+		Timeout:    30 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotRegistered", nil
+				}
+
+				return resp, "Error", fmt.Errorf("Error retrieving Backup Protection Container %q (Vault %q / Resource Group %q): %+v", containerName, vaultName, resourceGroup, err)
+			}
+
+			if properties := resp.Properties; properties != nil {
+				if container, ok := properties.AsAzureStorageContainer(); ok && container != nil && container.RegistrationStatus != nil {
+					if strings.EqualFold(*container.RegistrationStatus, "Registered") {
+						return resp, "Registered", nil
+					}
+				}
+			}
+
+			return resp, "NotRegistered", nil
+		},
+	}
+
+	if registered {
+		state.Pending = []string{"NotRegistered"}
+		state.Target = []string{"Registered"}
+	} else {
+		state.Pending = []string{"Registered"}
+		state.Target = []string{"NotRegistered"}
+	}
+
+	resp, err := state.WaitForState()
+	if err != nil {
+		i, _ := resp.(backup.ProtectionContainerResource)
+		return i, fmt.Errorf("Error waiting for Backup Protection Container %q (Vault %q / Resource Group %q) to be registered=%t: %+v", containerName, vaultName, resourceGroup, registered, err)
+	}
+
+	return resp.(backup.ProtectionContainerResource), nil
+}
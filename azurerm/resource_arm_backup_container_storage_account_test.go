@@ -0,0 +1,180 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMBackupContainerStorageAccount_basic(t *testing.T) {
+	resourceName := "azurerm_backup_container_storage_account.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupContainerStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupContainerStorageAccount_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupContainerStorageAccountExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBackupContainerStorageAccount_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_backup_container_storage_account.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupContainerStorageAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupContainerStorageAccount_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupContainerStorageAccountExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMBackupContainerStorageAccount_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_backup_container_storage_account"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBackupContainerStorageAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServices.BackupProtectionContainersClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_backup_container_storage_account" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		vaultName := id.Path["vaults"]
+		containerName := id.Path["protectionContainers"]
+
+		resp, err := client.Get(ctx, vaultName, id.ResourceGroup, "Azure", containerName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Backup Protection Container still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBackupContainerStorageAccountExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).recoveryServices.BackupProtectionContainersClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		vaultName := id.Path["vaults"]
+		containerName := id.Path["protectionContainers"]
+
+		resp, err := client.Get(ctx, vaultName, id.ResourceGroup, "Azure", containerName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Backup Protection Container %q (Vault %q / Resource Group %q) was not found: %+v", containerName, vaultName, id.ResourceGroup, err)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesBackupProtectionContainersClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMBackupContainerStorageAccount_template(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMBackupContainerStorageAccount_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_container_storage_account" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  storage_account_id  = "${azurerm_storage_account.test.id}"
+}
+`, testAccAzureRMBackupContainerStorageAccount_template(rInt, rString, location))
+}
+
+func testAccAzureRMBackupContainerStorageAccount_requiresImport(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_container_storage_account" "import" {
+  resource_group_name = "${azurerm_backup_container_storage_account.test.resource_group_name}"
+  recovery_vault_name = "${azurerm_backup_container_storage_account.test.recovery_vault_name}"
+  storage_account_id  = "${azurerm_backup_container_storage_account.test.storage_account_id}"
+}
+`, testAccAzureRMBackupContainerStorageAccount_basic(rInt, rString, location))
+}
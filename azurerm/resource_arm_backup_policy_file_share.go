@@ -0,0 +1,245 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBackupPolicyFileShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupPolicyFileShareCreateUpdate,
+		Read:   resourceArmBackupPolicyFileShareRead,
+		Update: resourceArmBackupPolicyFileShareCreateUpdate,
+		Delete: resourceArmBackupPolicyFileShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile("^[a-zA-Z][-_!a-zA-Z0-9]{2,149}$"),
+					"Backup Policy name must be 3 - 150 characters long, start with a letter, contain only letters and numbers.",
+				),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRecoveryServicesVaultName,
+			},
+
+			"timezone": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "UTC",
+			},
+
+			"backup": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// File Shares can only be backed up once per day
+						"frequency": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(backup.ScheduleRunTypeDaily),
+							}, true),
+						},
+
+						"time": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringMatch(
+								regexp.MustCompile("^([01][0-9]|[2][0-3]):([03][0])$"), //time must be on the hour or half past
+								"Time of day must match the format HH:mm where HH is 00-23 and mm is 00 or 30",
+							),
+						},
+					},
+				},
+			},
+
+			"retention_daily": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 180),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmBackupPolicyFileShareCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServices.ProtectionPoliciesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	policyName := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+
+	log.Printf("[DEBUG] Creating/updating Backup File Share Policy %q (Resource Group %q)", policyName, resourceGroup)
+
+	// the schedule and retention times must match, so are both derived from `backup.0.time`
+	timeOfDay := d.Get("backup.0.time").(string)
+	dateOfDay, err := time.Parse(time.RFC3339, fmt.Sprintf("2018-07-30T%s:00Z", timeOfDay))
+	if err != nil {
+		return fmt.Errorf("Error generating time from %q for policy %q (Resource Group %q): %+v", timeOfDay, policyName, resourceGroup, err)
+	}
+	times := append(make([]date.Time, 0), date.Time{Time: dateOfDay})
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Backup File Share Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_backup_policy_file_share", *existing.ID)
+		}
+	}
+
+	policy := backup.ProtectionPolicyResource{
+		Properties: &backup.AzureFileShareProtectionPolicy{
+			TimeZone:             utils.String(d.Get("timezone").(string)),
+			BackupManagementType: backup.BackupManagementTypeAzureStorage,
+			WorkLoadType:         backup.WorkloadTypeAzureFileShare,
+			SchedulePolicy:       expandArmRecoveryServicesProtectionPolicySchedule(d, times),
+			RetentionPolicy: &backup.LongTermRetentionPolicy{
+				RetentionPolicyType: backup.RetentionPolicyTypeLongTermRetentionPolicy,
+				DailySchedule:       expandArmRecoveryServicesProtectionPolicyRetentionDaily(d, times),
+			},
+		},
+	}
+	if _, err = client.CreateOrUpdate(ctx, vaultName, resourceGroup, policyName, policy); err != nil {
+		return fmt.Errorf("Error creating/updating Backup File Share Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+	}
+
+	resp, err := resourceArmRecoveryServicesProtectionPolicyWaitForState(client, ctx, true, vaultName, resourceGroup, policyName)
+	if err != nil {
+		return err
+	}
+
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmBackupPolicyFileShareRead(d, meta)
+}
+
+func resourceArmBackupPolicyFileShareRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServices.ProtectionPoliciesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["backupPolicies"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+
+	log.Printf("[DEBUG] Reading Backup File Share Policy %q (Resource Group %q)", policyName, resourceGroup)
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Backup File Share Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+	}
+
+	d.Set("name", policyName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties, ok := resp.Properties.AsAzureFileShareProtectionPolicy(); ok && properties != nil {
+		d.Set("timezone", properties.TimeZone)
+
+		if schedule, ok := properties.SchedulePolicy.AsSimpleSchedulePolicy(); ok && schedule != nil {
+			if err := d.Set("backup", flattenArmRecoveryServicesProtectionPolicySchedule(schedule)); err != nil {
+				return fmt.Errorf("Error setting `backup`: %+v", err)
+			}
+		}
+
+		if retention, ok := properties.RetentionPolicy.AsLongTermRetentionPolicy(); ok && retention != nil {
+			if s := retention.DailySchedule; s != nil {
+				if err := d.Set("retention_daily", flattenArmRecoveryServicesProtectionPolicyRetentionDaily(s)); err != nil {
+					return fmt.Errorf("Error setting `retention_daily`: %+v", err)
+				}
+			} else {
+				d.Set("retention_daily", nil)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBackupPolicyFileShareDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServices.ProtectionPoliciesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policyName := id.Path["backupPolicies"]
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+
+	log.Printf("[DEBUG] Deleting Backup File Share Policy %q (Resource Group %q)", policyName, resourceGroup)
+
+	resp, err := client.Delete(ctx, vaultName, resourceGroup, policyName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error issuing delete request for Backup File Share Policy %q (Resource Group %q): %+v", policyName, resourceGroup, err)
+		}
+	}
+
+	if _, err := resourceArmRecoveryServicesProtectionPolicyWaitForState(client, ctx, false, vaultName, resourceGroup, policyName); err != nil {
+		return err
+	}
+
+	return nil
+}
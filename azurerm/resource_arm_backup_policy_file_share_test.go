@@ -0,0 +1,186 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMBackupPolicyFileShare_basic(t *testing.T) {
+	resourceName := "azurerm_backup_policy_file_share.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupPolicyFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupPolicyFileShare_basic(ri, testLocation(), 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyFileShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.frequency", "Daily"),
+					resource.TestCheckResourceAttr(resourceName, "backup.0.time", "23:00"),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "10"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMBackupPolicyFileShare_basic(ri, testLocation(), 20),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyFileShareExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "20"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBackupPolicyFileShare_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_backup_policy_file_share.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupPolicyFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupPolicyFileShare_basic(ri, location, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupPolicyFileShareExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMBackupPolicyFileShare_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_backup_policy_file_share"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBackupPolicyFileShareDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServices.ProtectionPoliciesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_backup_policy_file_share" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		policyName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Backup File Share Policy still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBackupPolicyFileShareExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).recoveryServices.ProtectionPoliciesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		vaultName := rs.Primary.Attributes["recovery_vault_name"]
+		policyName := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Backup File Share Policy %q (Vault %q)", policyName, vaultName)
+		}
+
+		resp, err := client.Get(ctx, vaultName, resourceGroup, policyName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Backup File Share Policy %q (Resource Group %q) was not found: %+v", policyName, resourceGroup, err)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesProtectionPoliciesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMBackupPolicyFileShare_basic(rInt int, location string, retention int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_backup_policy_file_share" "test" {
+  name                = "acctest-policy-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = %[3]d
+  }
+}
+`, rInt, location, retention)
+}
+
+func testAccAzureRMBackupPolicyFileShare_requiresImport(rInt int, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_policy_file_share" "import" {
+  name                = "${azurerm_backup_policy_file_share.test.name}"
+  resource_group_name = "${azurerm_backup_policy_file_share.test.resource_group_name}"
+  recovery_vault_name = "${azurerm_backup_policy_file_share.test.recovery_vault_name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+`, testAccAzureRMBackupPolicyFileShare_basic(rInt, location, 10))
+}
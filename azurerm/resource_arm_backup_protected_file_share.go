@@ -0,0 +1,251 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/recoveryservices/mgmt/2017-07-01/backup"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmBackupProtectedFileShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmBackupProtectedFileShareCreateUpdate,
+		Read:   resourceArmBackupProtectedFileShareRead,
+		Update: resourceArmBackupProtectedFileShareCreateUpdate,
+		Delete: resourceArmBackupProtectedFileShareDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"recovery_vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateRecoveryServicesVaultName,
+			},
+
+			"source_storage_account_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"source_file_share_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"backup_policy_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+		},
+	}
+}
+
+func resourceArmBackupProtectedFileShareCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServices.ProtectedItemsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	vaultName := d.Get("recovery_vault_name").(string)
+	storageAccountId := d.Get("source_storage_account_id").(string)
+	fileShareName := d.Get("source_file_share_name").(string)
+	policyId := d.Get("backup_policy_id").(string)
+
+	parsedStorageAccountId, err := azure.ParseAzureResourceID(storageAccountId)
+	if err != nil {
+		return fmt.Errorf("Error parsing `source_storage_account_id` %q: %+v", storageAccountId, err)
+	}
+	accountName, hasName := parsedStorageAccountId.Path["storageAccounts"]
+	if !hasName {
+		return fmt.Errorf("Error parsing `source_storage_account_id` %q: doesn't contain `storageAccounts`", storageAccountId)
+	}
+
+	protectedItemName := fmt.Sprintf("AzureFileShare;%s", fileShareName)
+	containerName := fmt.Sprintf("StorageContainer;storage;%s;%s", parsedStorageAccountId.ResourceGroup, accountName)
+
+	log.Printf("[DEBUG] Creating/updating Backup Protected File Share %q (Resource Group %q)", protectedItemName, resourceGroup)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Backup Protected File Share %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_backup_protected_file_share", *existing.ID)
+		}
+	}
+
+	item := backup.ProtectedItemResource{
+		Properties: &backup.AzureFileshareProtectedItem{
+			PolicyID:          utils.String(policyId),
+			ProtectedItemType: backup.ProtectedItemTypeAzureFileShareProtectedItem,
+			WorkloadType:      backup.DataSourceTypeAzureFileShare,
+			SourceResourceID:  utils.String(storageAccountId),
+			FriendlyName:      utils.String(fileShareName),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, item); err != nil {
+		return fmt.Errorf("Error creating/updating Backup Protected File Share %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+	}
+
+	resp, err := resourceArmBackupProtectedFileShareWaitForState(ctx, client, true, vaultName, resourceGroup, containerName, protectedItemName, policyId, d.IsNewResource())
+	if err != nil {
+		return err
+	}
+
+	id := strings.Replace(*resp.ID, "Subscriptions", "subscriptions", 1)
+	d.SetId(id)
+
+	return resourceArmBackupProtectedFileShareRead(d, meta)
+}
+
+func resourceArmBackupProtectedFileShareRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServices.ProtectedItemsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protectedItemName := id.Path["protectedItems"]
+	vaultName := id.Path["vaults"]
+	resourceGroup := id.ResourceGroup
+	containerName := id.Path["protectionContainers"]
+
+	log.Printf("[DEBUG] Reading Backup Protected File Share %q (Resource Group %q)", protectedItemName, resourceGroup)
+
+	resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Backup Protected File Share %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("recovery_vault_name", vaultName)
+
+	if properties := resp.Properties; properties != nil {
+		if item, ok := properties.AsAzureFileshareProtectedItem(); ok {
+			d.Set("source_storage_account_id", item.SourceResourceID)
+			d.Set("source_file_share_name", item.FriendlyName)
+
+			if v := item.PolicyID; v != nil {
+				d.Set("backup_policy_id", strings.Replace(*v, "Subscriptions", "subscriptions", 1))
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmBackupProtectedFileShareDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).recoveryServices.ProtectedItemsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	protectedItemName := id.Path["protectedItems"]
+	resourceGroup := id.ResourceGroup
+	vaultName := id.Path["vaults"]
+	containerName := id.Path["protectionContainers"]
+
+	log.Printf("[DEBUG] Deleting Backup Protected File Share %q (Resource Group %q)", protectedItemName, resourceGroup)
+
+	resp, err := client.Delete(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error issuing delete request for Backup Protected File Share %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+		}
+	}
+
+	if _, err := resourceArmBackupProtectedFileShareWaitForState(ctx, client, false, vaultName, resourceGroup, containerName, protectedItemName, "", false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceArmBackupProtectedFileShareWaitForState(ctx context.Context, client *backup.ProtectedItemsGroupClient, found bool, vaultName, resourceGroup, containerName, protectedItemName string, policyId string, newResource bool) (backup.ProtectedItemResource, error) {
+	state := &resource.StateChangeConf{
+		Timeout:    30 * time.Minute,
+		MinTimeout: 30 * time.Second,
+		Delay:      10 * time.Second,
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, vaultName, resourceGroup, "Azure", containerName, protectedItemName, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return resp, "NotFound", nil
+				}
+
+				return resp, "Error", fmt.Errorf("Error making Read request on Backup Protected File Share %q (Resource Group %q): %+v", protectedItemName, resourceGroup, err)
+			}
+
+			// when updating, wait for the new Backup Policy to be applied
+			if !newResource && policyId != "" {
+				if properties := resp.Properties; properties != nil {
+					if item, ok := properties.AsAzureFileshareProtectedItem(); ok && item.PolicyID != nil {
+						if !strings.EqualFold(*item.PolicyID, policyId) {
+							return resp, "NotFound", nil
+						}
+					}
+				}
+			}
+
+			return resp, "Found", nil
+		},
+	}
+
+	if found {
+		state.Pending = []string{"NotFound"}
+		state.Target = []string{"Found"}
+	} else {
+		state.Pending = []string{"Found"}
+		state.Target = []string{"NotFound"}
+	}
+
+	resp, err := state.WaitForState()
+	if err != nil {
+		i, _ := resp.(backup.ProtectedItemResource)
+		return i, fmt.Errorf("Error waiting for the Backup Protected File Share %q to be %t (Resource Group %q) to provision: %+v", protectedItemName, found, resourceGroup, err)
+	}
+
+	return resp.(backup.ProtectedItemResource), nil
+}
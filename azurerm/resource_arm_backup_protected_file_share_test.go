@@ -0,0 +1,281 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMBackupProtectedFileShare_basic(t *testing.T) {
+	resourceName := "azurerm_backup_protected_file_share.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupProtectedFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupProtectedFileShare_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupProtectedFileShareExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "backup_policy_id", "azurerm_backup_policy_file_share.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{ //vault cannot be deleted unless we unregister all backups
+				Config: testAccAzureRMBackupProtectedFileShare_base(ri, rs, testLocation()),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBackupProtectedFileShare_updateBackupPolicyId(t *testing.T) {
+	resourceName := "azurerm_backup_protected_file_share.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupProtectedFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupProtectedFileShare_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupProtectedFileShareExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "backup_policy_id", "azurerm_backup_policy_file_share.test", "id"),
+				),
+			},
+			{
+				Config: testAccAzureRMBackupProtectedFileShare_updatePolicy(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupProtectedFileShareExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "backup_policy_id", "azurerm_backup_policy_file_share.test2", "id"),
+				),
+			},
+			{ //vault cannot be deleted unless we unregister all backups
+				Config: testAccAzureRMBackupProtectedFileShare_base(ri, rs, testLocation()),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMBackupProtectedFileShare_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_backup_protected_file_share.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMBackupProtectedFileShareDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMBackupProtectedFileShare_basic(ri, rs, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMBackupProtectedFileShareExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMBackupProtectedFileShare_requiresImport(ri, rs, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_backup_protected_file_share"),
+			},
+			{ //vault cannot be deleted unless we unregister all backups
+				Config: testAccAzureRMBackupProtectedFileShare_base(ri, rs, testLocation()),
+				Check:  resource.ComposeTestCheckFunc(),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMBackupProtectedFileShareDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).recoveryServices.ProtectedItemsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_backup_protected_file_share" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		vaultName := id.Path["vaults"]
+		containerName := id.Path["protectionContainers"]
+		protectedItemName := id.Path["protectedItems"]
+
+		resp, err := client.Get(ctx, vaultName, id.ResourceGroup, "Azure", containerName, protectedItemName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Backup Protected File Share still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMBackupProtectedFileShareExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).recoveryServices.ProtectedItemsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %q", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		vaultName := id.Path["vaults"]
+		containerName := id.Path["protectionContainers"]
+		protectedItemName := id.Path["protectedItems"]
+
+		resp, err := client.Get(ctx, vaultName, id.ResourceGroup, "Azure", containerName, protectedItemName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Backup Protected File Share %q (Resource Group %q) was not found: %+v", protectedItemName, id.ResourceGroup, err)
+			}
+
+			return fmt.Errorf("Bad: Get on recoveryServicesProtectedItemsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMBackupProtectedFileShare_base(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_recovery_services_vault" "test" {
+  name                = "acctest-vault-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%[3]s"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "acctest-ss-%[1]d"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+
+resource "azurerm_backup_policy_file_share" "test" {
+  name                = "acctest-policy-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:00"
+  }
+
+  retention_daily {
+    count = 10
+  }
+}
+
+resource "azurerm_backup_policy_file_share" "test2" {
+  name                = "acctest-policy2-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+
+  backup {
+    frequency = "Daily"
+    time      = "23:30"
+  }
+
+  retention_daily {
+    count = 20
+  }
+}
+
+resource "azurerm_backup_container_storage_account" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  storage_account_id  = "${azurerm_storage_account.test.id}"
+}
+`, rInt, location, rString)
+}
+
+func testAccAzureRMBackupProtectedFileShare_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_file_share" "test" {
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  recovery_vault_name       = "${azurerm_recovery_services_vault.test.name}"
+  source_storage_account_id = "${azurerm_backup_container_storage_account.test.storage_account_id}"
+  source_file_share_name    = "${azurerm_storage_share.test.name}"
+  backup_policy_id          = "${azurerm_backup_policy_file_share.test.id}"
+}
+`, testAccAzureRMBackupProtectedFileShare_base(rInt, rString, location))
+}
+
+func testAccAzureRMBackupProtectedFileShare_updatePolicy(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_file_share" "test" {
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  recovery_vault_name       = "${azurerm_recovery_services_vault.test.name}"
+  source_storage_account_id = "${azurerm_backup_container_storage_account.test.storage_account_id}"
+  source_file_share_name    = "${azurerm_storage_share.test.name}"
+  backup_policy_id          = "${azurerm_backup_policy_file_share.test2.id}"
+}
+`, testAccAzureRMBackupProtectedFileShare_base(rInt, rString, location))
+}
+
+func testAccAzureRMBackupProtectedFileShare_requiresImport(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_backup_protected_file_share" "import" {
+  resource_group_name       = "${azurerm_backup_protected_file_share.test.resource_group_name}"
+  recovery_vault_name       = "${azurerm_backup_protected_file_share.test.recovery_vault_name}"
+  source_storage_account_id = "${azurerm_backup_protected_file_share.test.source_storage_account_id}"
+  source_file_share_name    = "${azurerm_backup_protected_file_share.test.source_file_share_name}"
+  backup_policy_id          = "${azurerm_backup_protected_file_share.test.backup_policy_id}"
+}
+`, testAccAzureRMBackupProtectedFileShare_basic(rInt, rString, location))
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 
 	"github.com/Azure/azure-sdk-for-go/services/batch/mgmt/2018-12-01/batch"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
 func resourceArmBatchPool() *schema.Resource {
@@ -127,6 +128,15 @@ func resourceArmBatchPool() *schema.Resource {
 							Optional:     true,
 							ValidateFunc: validate.NoEmptyStrings,
 						},
+						"container_image_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
 						"container_registries": {
 							Type:       schema.TypeList,
 							Optional:   true,
@@ -257,6 +267,24 @@ func resourceArmBatchPool() *schema.Resource {
 					},
 				},
 			},
+			"application_package": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+					},
+				},
+			},
 			"start_task": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -368,7 +396,8 @@ func resourceArmBatchPool() *schema.Resource {
 
 func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).batch.PoolClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure Batch pool creation.")
 
@@ -464,6 +493,9 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 	}
 	parameters.PoolProperties.Certificates = certificateReferences
 
+	applicationPackages := d.Get("application_package").([]interface{})
+	parameters.PoolProperties.ApplicationPackages = azure.ExpandBatchPoolApplicationPackageReferences(applicationPackages)
+
 	if err := validateBatchPoolCrossFieldRules(&parameters); err != nil {
 		return err
 	}
@@ -499,7 +531,8 @@ func resourceArmBatchPoolCreate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).batch.PoolClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
@@ -567,6 +600,9 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 	parameters.PoolProperties.Certificates = certificateReferences
 
+	applicationPackages := d.Get("application_package").([]interface{})
+	parameters.PoolProperties.ApplicationPackages = azure.ExpandBatchPoolApplicationPackageReferences(applicationPackages)
+
 	if err := validateBatchPoolCrossFieldRules(&parameters); err != nil {
 		return err
 	}
@@ -587,7 +623,8 @@ func resourceArmBatchPoolUpdate(d *schema.ResourceData, meta interface{}) error
 }
 
 func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).batch.PoolClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
@@ -642,6 +679,10 @@ func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
 			return fmt.Errorf("Error flattening `certificate`: %+v", err)
 		}
 
+		if err := d.Set("application_package", azure.FlattenBatchPoolApplicationPackageReferences(props.ApplicationPackages)); err != nil {
+			return fmt.Errorf("Error flattening `application_package`: %+v", err)
+		}
+
 		d.Set("start_task", azure.FlattenBatchPoolStartTask(props.StartTask))
 	}
 
@@ -649,7 +690,8 @@ func resourceArmBatchPoolRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceArmBatchPoolDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).batch.PoolClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
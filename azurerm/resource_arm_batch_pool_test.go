@@ -312,6 +312,7 @@ func TestAccAzureRMBatchPool_container(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMBatchPoolExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "container_configuration.0.type", "DockerCompatible"),
+					resource.TestCheckResourceAttr(resourceName, "container_configuration.0.container_image_names.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "container_configuration.0.container_registries.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "container_configuration.0.container_registries.0.registry_server", "myContainerRegistry.azurecr.io"),
 					resource.TestCheckResourceAttr(resourceName, "container_configuration.0.container_registries.0.user_name", "myUserName"),
@@ -1016,7 +1017,8 @@ resource "azurerm_batch_pool" "test" {
   }
 
   container_configuration {
-    type = "DockerCompatible"
+    type                  = "DockerCompatible"
+    container_image_names = ["centos7"]
     container_registries= [
       {
         registry_server = "myContainerRegistry.azurecr.io"
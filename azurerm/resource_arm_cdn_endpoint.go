@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"regexp"
 
 	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -11,8 +12,10 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -165,6 +168,77 @@ func resourceArmCdnEndpoint() *schema.Resource {
 				},
 			},
 
+			"global_delivery_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cache_expiration_action": schemaCdnEndpointCacheExpirationAction(),
+					},
+				},
+			},
+
+			"delivery_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						// order `0` is reserved for the `global_delivery_rule`
+						"order": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"url_path_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(cdn.Literal),
+											string(cdn.Wildcard),
+										}, false),
+									},
+
+									"path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
+
+						"url_file_extension_condition": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"extensions": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validate.NoEmptyStrings,
+										},
+									},
+								},
+							},
+						},
+
+						"cache_expiration_action": schemaCdnEndpointCacheExpirationAction(),
+					},
+				},
+			},
+
 			"optimization_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -190,7 +264,8 @@ func resourceArmCdnEndpoint() *schema.Resource {
 
 func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cdn.EndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM CDN EndPoint creation.")
 
@@ -228,6 +303,12 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error expanding `geo_filter`: %s", err)
 	}
 
+	if hasDeliveryRules(d) {
+		if err := validateCdnEndpointDeliveryRulesSupported(d, meta); err != nil {
+			return err
+		}
+	}
+
 	endpoint := cdn.Endpoint{
 		Location: &location,
 		EndpointProperties: &cdn.EndpointProperties{
@@ -260,6 +341,14 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 		endpoint.EndpointProperties.Origins = &origins
 	}
 
+	if hasDeliveryRules(d) {
+		deliveryPolicy, err := expandArmCdnEndpointDeliveryPolicy(d)
+		if err != nil {
+			return err
+		}
+		endpoint.EndpointProperties.DeliveryPolicy = deliveryPolicy
+	}
+
 	future, err := client.Create(ctx, resourceGroup, profileName, name, endpoint)
 	if err != nil {
 		return fmt.Errorf("Error creating CDN Endpoint %q (Profile %q / Resource Group %q): %+v", name, profileName, resourceGroup, err)
@@ -281,7 +370,8 @@ func resourceArmCdnEndpointCreate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 	endpointsClient := meta.(*ArmClient).cdn.EndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -325,6 +415,21 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 		endpoint.EndpointPropertiesUpdateParameters.ProbePath = utils.String(probePath)
 	}
 
+	// only send the Delivery Policy when it's changed, since it's not supported by all CDN SKU's
+	if d.HasChange("global_delivery_rule") || d.HasChange("delivery_rule") {
+		if hasDeliveryRules(d) {
+			if err := validateCdnEndpointDeliveryRulesSupported(d, meta); err != nil {
+				return err
+			}
+		}
+
+		deliveryPolicy, err := expandArmCdnEndpointDeliveryPolicy(d)
+		if err != nil {
+			return err
+		}
+		endpoint.EndpointPropertiesUpdateParameters.DeliveryPolicy = deliveryPolicy
+	}
+
 	future, err := endpointsClient.Update(ctx, resourceGroup, profileName, name, endpoint)
 	if err != nil {
 		return fmt.Errorf("Error updating CDN Endpoint %q (Profile %q / Resource Group %q): %s", name, profileName, resourceGroup, err)
@@ -339,7 +444,8 @@ func resourceArmCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) erro
 
 func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cdn.EndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -391,6 +497,14 @@ func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("Error setting `geo_filter`: %+v", err)
 		}
 
+		globalDeliveryRules, deliveryRules := flattenArmCdnEndpointDeliveryPolicy(props.DeliveryPolicy)
+		if err := d.Set("global_delivery_rule", globalDeliveryRules); err != nil {
+			return fmt.Errorf("Error setting `global_delivery_rule`: %+v", err)
+		}
+		if err := d.Set("delivery_rule", deliveryRules); err != nil {
+			return fmt.Errorf("Error setting `delivery_rule`: %+v", err)
+		}
+
 		origins := flattenAzureRMCdnEndpointOrigin(props.Origins)
 		if err := d.Set("origin", origins); err != nil {
 			return fmt.Errorf("Error setting `origin`: %+v", err)
@@ -402,7 +516,8 @@ func resourceArmCdnEndpointRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmCdnEndpointDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cdn.EndpointsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -574,3 +689,242 @@ func flattenAzureRMCdnEndpointOrigin(input *[]cdn.DeepCreatedOrigin) []interface
 
 	return results
 }
+
+func schemaCdnEndpointCacheExpirationAction() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"behavior": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(cdn.BypassCache),
+						string(cdn.Override),
+						string(cdn.SetIfMissing),
+					}, false),
+				},
+
+				"duration": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^([0-9]+\.)?([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$`),
+						"The Cache Duration must be in the format `[d.]hh:mm:ss`",
+					),
+				},
+			},
+		},
+	}
+}
+
+func hasDeliveryRules(d *schema.ResourceData) bool {
+	globalRules := d.Get("global_delivery_rule").([]interface{})
+	deliveryRules := d.Get("delivery_rule").([]interface{})
+	return len(globalRules) > 0 || len(deliveryRules) > 0
+}
+
+// Delivery Rules are only available for CDN Profiles using the `Standard_Microsoft` SKU
+func validateCdnEndpointDeliveryRulesSupported(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdn.ProfilesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	profileName := d.Get("profile_name").(string)
+
+	profile, err := client.Get(ctx, resourceGroup, profileName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving CDN Profile %q (Resource Group %q): %+v", profileName, resourceGroup, err)
+	}
+
+	if profile.Sku == nil || profile.Sku.Name != cdn.StandardMicrosoft {
+		return fmt.Errorf("`global_delivery_rule` and `delivery_rule` are only supported for CDN Profiles using the `%s` SKU", string(cdn.StandardMicrosoft))
+	}
+
+	return nil
+}
+
+func expandArmCdnEndpointDeliveryPolicy(d *schema.ResourceData) (*cdn.EndpointPropertiesUpdateParametersDeliveryPolicy, error) {
+	rules := make([]cdn.DeliveryRule, 0)
+
+	for _, v := range d.Get("global_delivery_rule").([]interface{}) {
+		input := v.(map[string]interface{})
+		actions, err := expandArmCdnEndpointCacheExpirationAction(input["cache_expiration_action"].([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("Error expanding `global_delivery_rule`: %+v", err)
+		}
+
+		rules = append(rules, cdn.DeliveryRule{
+			Order:   utils.Int32(0),
+			Actions: actions,
+		})
+	}
+
+	for _, v := range d.Get("delivery_rule").([]interface{}) {
+		input := v.(map[string]interface{})
+
+		conditions := make([]cdn.BasicDeliveryRuleCondition, 0)
+		for _, c := range input["url_path_condition"].([]interface{}) {
+			condition := c.(map[string]interface{})
+			conditions = append(conditions, cdn.DeliveryRuleURLPathCondition{
+				Name: cdn.NameURLPath,
+				Parameters: &cdn.URLPathConditionParameters{
+					OdataType: utils.String("#Microsoft.Azure.Cdn.Models.DeliveryRuleUrlPathConditionParameters"),
+					MatchType: cdn.MatchType(condition["match_type"].(string)),
+					Path:      utils.String(condition["path"].(string)),
+				},
+			})
+		}
+		for _, c := range input["url_file_extension_condition"].([]interface{}) {
+			condition := c.(map[string]interface{})
+			extensions := make([]string, 0)
+			for _, extension := range condition["extensions"].([]interface{}) {
+				extensions = append(extensions, extension.(string))
+			}
+			conditions = append(conditions, cdn.DeliveryRuleURLFileExtensionCondition{
+				Name: cdn.NameURLFileExtension,
+				Parameters: &cdn.URLFileExtensionConditionParameters{
+					OdataType:  utils.String("#Microsoft.Azure.Cdn.Models.DeliveryRuleUrlFileExtensionConditionParameters"),
+					Extensions: &extensions,
+				},
+			})
+		}
+
+		order := input["order"].(int)
+		actions, err := expandArmCdnEndpointCacheExpirationAction(input["cache_expiration_action"].([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("Error expanding `delivery_rule` with order %d: %+v", order, err)
+		}
+
+		rules = append(rules, cdn.DeliveryRule{
+			Order:      utils.Int32(int32(order)),
+			Conditions: &conditions,
+			Actions:    actions,
+		})
+	}
+
+	return &cdn.EndpointPropertiesUpdateParametersDeliveryPolicy{
+		Rules: &rules,
+	}, nil
+}
+
+func expandArmCdnEndpointCacheExpirationAction(input []interface{}) (*[]cdn.BasicDeliveryRuleAction, error) {
+	actions := make([]cdn.BasicDeliveryRuleAction, 0)
+
+	for _, v := range input {
+		action := v.(map[string]interface{})
+
+		behavior := action["behavior"].(string)
+		parameters := cdn.CacheExpirationActionParameters{
+			OdataType:     utils.String("#Microsoft.Azure.Cdn.Models.DeliveryRuleCacheExpirationActionParameters"),
+			CacheBehavior: cdn.CacheBehavior(behavior),
+			CacheType:     utils.String("All"),
+		}
+
+		duration := action["duration"].(string)
+		if duration != "" {
+			parameters.CacheDuration = utils.String(duration)
+		} else if behavior != string(cdn.BypassCache) {
+			return nil, fmt.Errorf("`duration` must be set when `behavior` is %q", behavior)
+		}
+
+		actions = append(actions, cdn.DeliveryRuleCacheExpirationAction{
+			Name:       cdn.NameCacheExpiration,
+			Parameters: &parameters,
+		})
+	}
+
+	return &actions, nil
+}
+
+func flattenArmCdnEndpointDeliveryPolicy(input *cdn.EndpointPropertiesUpdateParametersDeliveryPolicy) ([]interface{}, []interface{}) {
+	globalRules := make([]interface{}, 0)
+	deliveryRules := make([]interface{}, 0)
+
+	if input == nil || input.Rules == nil {
+		return globalRules, deliveryRules
+	}
+
+	for _, rule := range *input.Rules {
+		order := 0
+		if rule.Order != nil {
+			order = int(*rule.Order)
+		}
+
+		cacheExpirationActions := flattenArmCdnEndpointCacheExpirationAction(rule.Actions)
+
+		if order == 0 {
+			globalRules = append(globalRules, map[string]interface{}{
+				"cache_expiration_action": cacheExpirationActions,
+			})
+			continue
+		}
+
+		urlPathConditions := make([]interface{}, 0)
+		urlFileExtensionConditions := make([]interface{}, 0)
+		if conditions := rule.Conditions; conditions != nil {
+			for _, c := range *conditions {
+				if condition, ok := c.AsDeliveryRuleURLPathCondition(); ok && condition.Parameters != nil {
+					path := ""
+					if condition.Parameters.Path != nil {
+						path = *condition.Parameters.Path
+					}
+					urlPathConditions = append(urlPathConditions, map[string]interface{}{
+						"match_type": string(condition.Parameters.MatchType),
+						"path":       path,
+					})
+				}
+
+				if condition, ok := c.AsDeliveryRuleURLFileExtensionCondition(); ok && condition.Parameters != nil {
+					extensions := make([]interface{}, 0)
+					if condition.Parameters.Extensions != nil {
+						for _, extension := range *condition.Parameters.Extensions {
+							extensions = append(extensions, extension)
+						}
+					}
+					urlFileExtensionConditions = append(urlFileExtensionConditions, map[string]interface{}{
+						"extensions": extensions,
+					})
+				}
+			}
+		}
+
+		deliveryRules = append(deliveryRules, map[string]interface{}{
+			"order":                        order,
+			"url_path_condition":           urlPathConditions,
+			"url_file_extension_condition": urlFileExtensionConditions,
+			"cache_expiration_action":      cacheExpirationActions,
+		})
+	}
+
+	return globalRules, deliveryRules
+}
+
+func flattenArmCdnEndpointCacheExpirationAction(input *[]cdn.BasicDeliveryRuleAction) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input == nil {
+		return results
+	}
+
+	for _, a := range *input {
+		action, ok := a.AsDeliveryRuleCacheExpirationAction()
+		if !ok || action.Parameters == nil {
+			continue
+		}
+
+		duration := ""
+		if action.Parameters.CacheDuration != nil {
+			duration = *action.Parameters.CacheDuration
+		}
+
+		results = append(results, map[string]interface{}{
+			"behavior": string(action.Parameters.CacheBehavior),
+			"duration": duration,
+		})
+	}
+
+	return results
+}
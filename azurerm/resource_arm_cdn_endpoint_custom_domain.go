@@ -0,0 +1,436 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cdn/mgmt/2017-10-12/cdn"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCdnEndpointCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCdnEndpointCustomDomainCreate,
+		Read:   resourceArmCdnEndpointCustomDomainRead,
+		Update: resourceArmCdnEndpointCustomDomainUpdate,
+		Delete: resourceArmCdnEndpointCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// enabling HTTPS requires the domain to be validated and a certificate to be issued and
+		// deployed to the CDN, which can take several hours
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(12 * time.Hour),
+			Update: schema.DefaultTimeout(12 * time.Hour),
+			Delete: schema.DefaultTimeout(12 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[a-zA-Z0-9]([-a-zA-Z0-9]{0,258}[a-zA-Z0-9])?$`),
+					"The CDN Endpoint Custom Domain name must be between 1 and 260 characters long, can only contain letters, numbers and hyphens and must start and end with a letter or number.",
+				),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"profile_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"endpoint_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"host_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"cdn_managed_https": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"user_managed_https"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(cdn.Dedicated),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.Dedicated),
+								string(cdn.Shared),
+							}, false),
+						},
+
+						"protocol_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(cdn.ServerNameIndication),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.IPBased),
+								string(cdn.ServerNameIndication),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"user_managed_https": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"cdn_managed_https"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key_vault_secret_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateKeyVaultChildId,
+						},
+
+						"protocol_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(cdn.ServerNameIndication),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cdn.IPBased),
+								string(cdn.ServerNameIndication),
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmCdnEndpointCustomDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdn.CustomDomainsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	profileName := d.Get("profile_name").(string)
+	endpointName := d.Get("endpoint_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_cdn_endpoint_custom_domain", *existing.ID)
+		}
+	}
+
+	parameters := cdn.CustomDomainParameters{
+		CustomDomainPropertiesParameters: &cdn.CustomDomainPropertiesParameters{
+			HostName: utils.String(d.Get("host_name").(string)),
+		},
+	}
+
+	future, err := client.Create(ctx, resourceGroup, profileName, endpointName, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error creating CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation of CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q) ID", name, endpointName, profileName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	httpsParameters, err := expandArmCdnEndpointCustomDomainHTTPSParameters(d, meta)
+	if err != nil {
+		return err
+	}
+
+	if httpsParameters != nil {
+		if err := enableArmCdnEndpointCustomDomainHTTPS(ctx, client, resourceGroup, profileName, endpointName, name, httpsParameters, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceArmCdnEndpointCustomDomainRead(d, meta)
+}
+
+func resourceArmCdnEndpointCustomDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdn.CustomDomainsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := id.Path["customDomains"]
+
+	if d.HasChange("cdn_managed_https") || d.HasChange("user_managed_https") {
+		httpsParameters, err := expandArmCdnEndpointCustomDomainHTTPSParameters(d, meta)
+		if err != nil {
+			return err
+		}
+
+		// switching between certificate sources requires HTTPS to be disabled first
+		existing, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+		}
+		if props := existing.CustomDomainProperties; props != nil && props.CustomHTTPSProvisioningState != cdn.Disabled {
+			if err := disableArmCdnEndpointCustomDomainHTTPS(ctx, client, resourceGroup, profileName, endpointName, name, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+
+		if httpsParameters != nil {
+			if err := enableArmCdnEndpointCustomDomainHTTPS(ctx, client, resourceGroup, profileName, endpointName, name, httpsParameters, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceArmCdnEndpointCustomDomainRead(d, meta)
+}
+
+func resourceArmCdnEndpointCustomDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdn.CustomDomainsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := id.Path["customDomains"]
+
+	resp, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] CDN Endpoint Custom Domain %q does not exist - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("profile_name", profileName)
+	d.Set("endpoint_name", endpointName)
+
+	if props := resp.CustomDomainProperties; props != nil {
+		d.Set("host_name", props.HostName)
+
+		// the API doesn't return the HTTPS configuration, so we can only detect when it's been disabled
+		if props.CustomHTTPSProvisioningState == cdn.Disabled || props.CustomHTTPSProvisioningState == cdn.Failed {
+			d.Set("cdn_managed_https", []interface{}{})
+			d.Set("user_managed_https", []interface{}{})
+		}
+	}
+
+	return nil
+}
+
+func resourceArmCdnEndpointCustomDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).cdn.CustomDomainsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	profileName := id.Path["profiles"]
+	endpointName := id.Path["endpoints"]
+	name := id.Path["customDomains"]
+
+	future, err := client.Delete(ctx, resourceGroup, profileName, endpointName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error waiting for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q) to be deleted: %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmCdnEndpointCustomDomainHTTPSParameters(d *schema.ResourceData, meta interface{}) (cdn.BasicCustomDomainHTTPSParameters, error) {
+	if v := d.Get("cdn_managed_https").([]interface{}); len(v) > 0 && v[0] != nil {
+		input := v[0].(map[string]interface{})
+		return cdn.ManagedHTTPSParameters{
+			CertificateSource: cdn.CertificateSourceCdn,
+			ProtocolType:      cdn.ProtocolType(input["protocol_type"].(string)),
+			CertificateSourceParameters: &cdn.CertificateSourceParameters{
+				OdataType:       utils.String("#Microsoft.Azure.Cdn.Models.CdnCertificateSourceParameters"),
+				CertificateType: cdn.CertificateType(input["certificate_type"].(string)),
+			},
+		}, nil
+	}
+
+	if v := d.Get("user_managed_https").([]interface{}); len(v) > 0 && v[0] != nil {
+		vaultClient := meta.(*ArmClient).keyvault.VaultsClient
+		ctx := meta.(*ArmClient).StopContext
+
+		input := v[0].(map[string]interface{})
+		keyVaultSecretId := input["key_vault_secret_id"].(string)
+
+		parsedSecretId, err := azure.ParseKeyVaultChildID(keyVaultSecretId)
+		if err != nil {
+			return nil, err
+		}
+
+		keyVaultBaseUrl := parsedSecretId.KeyVaultBaseUrl
+		keyVaultId, err := azure.GetKeyVaultIDFromBaseUrl(ctx, vaultClient, keyVaultBaseUrl)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving the Resource ID for the Key Vault at URL %q: %s", keyVaultBaseUrl, err)
+		}
+		if keyVaultId == nil {
+			return nil, fmt.Errorf("Unable to determine the Resource ID for the Key Vault at URL %q", keyVaultBaseUrl)
+		}
+
+		parsedKeyVaultId, err := azure.ParseAzureResourceID(*keyVaultId)
+		if err != nil {
+			return nil, err
+		}
+
+		return cdn.UserManagedHTTPSParameters{
+			CertificateSource: cdn.CertificateSourceAzureKeyVault,
+			ProtocolType:      cdn.ProtocolType(input["protocol_type"].(string)),
+			CertificateSourceParameters: &cdn.KeyVaultCertificateSourceParameters{
+				OdataType:         utils.String("#Microsoft.Azure.Cdn.Models.KeyVaultCertificateSourceParameters"),
+				SubscriptionID:    utils.String(parsedKeyVaultId.SubscriptionID),
+				ResourceGroupName: utils.String(parsedKeyVaultId.ResourceGroup),
+				VaultName:         utils.String(parsedKeyVaultId.Path["vaults"]),
+				SecretName:        utils.String(parsedSecretId.Name),
+				SecretVersion:     utils.String(parsedSecretId.Version),
+				UpdateRule:        utils.String("NoAction"),
+				DeleteRule:        utils.String("NoAction"),
+			},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func enableArmCdnEndpointCustomDomainHTTPS(ctx context.Context, client *cdn.CustomDomainsClient, resourceGroup, profileName, endpointName, name string, parameters cdn.BasicCustomDomainHTTPSParameters, timeout time.Duration) error {
+	log.Printf("[DEBUG] Enabling HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q)", name, endpointName, profileName, resourceGroup)
+
+	if _, err := client.EnableCustomHTTPS(ctx, resourceGroup, profileName, endpointName, name, &parameters); err != nil {
+		return fmt.Errorf("Error enabling HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(cdn.Disabled), string(cdn.Enabling)},
+		Target:     []string{string(cdn.Enabled)},
+		Refresh:    cdnEndpointCustomDomainHTTPSStateRefreshFunc(ctx, client, resourceGroup, profileName, endpointName, name),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Minute,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for HTTPS to be enabled for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func disableArmCdnEndpointCustomDomainHTTPS(ctx context.Context, client *cdn.CustomDomainsClient, resourceGroup, profileName, endpointName, name string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Disabling HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q)", name, endpointName, profileName, resourceGroup)
+
+	if _, err := client.DisableCustomHTTPS(ctx, resourceGroup, profileName, endpointName, name); err != nil {
+		return fmt.Errorf("Error disabling HTTPS for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{string(cdn.Enabled), string(cdn.Disabling)},
+		Target:     []string{string(cdn.Disabled)},
+		Refresh:    cdnEndpointCustomDomainHTTPSStateRefreshFunc(ctx, client, resourceGroup, profileName, endpointName, name),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Minute,
+		Delay:      30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for HTTPS to be disabled for CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func cdnEndpointCustomDomainHTTPSStateRefreshFunc(ctx context.Context, client *cdn.CustomDomainsClient, resourceGroup, profileName, endpointName, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): %+v", name, endpointName, profileName, resourceGroup, err)
+		}
+
+		props := resp.CustomDomainProperties
+		if props == nil {
+			return nil, "", fmt.Errorf("Error retrieving CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q): `properties` was nil", name, endpointName, profileName, resourceGroup)
+		}
+
+		switch props.CustomHTTPSProvisioningSubstate {
+		case cdn.DomainControlValidationRequestRejected, cdn.DomainControlValidationRequestTimedOut:
+			return resp, string(cdn.Failed), fmt.Errorf("Domain Control Validation failed for CDN Endpoint Custom Domain %q: %s", name, string(props.CustomHTTPSProvisioningSubstate))
+		}
+
+		log.Printf("[DEBUG] HTTPS for CDN Endpoint Custom Domain %q is %q (%q)", name, string(props.CustomHTTPSProvisioningState), string(props.CustomHTTPSProvisioningSubstate))
+		return resp, string(props.CustomHTTPSProvisioningState), nil
+	}
+}
@@ -0,0 +1,269 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// NOTE: these tests require an existing DNS Zone, since the Custom Domain has to be mapped to the
+// CDN Endpoint using a CNAME record before it can be created
+func testAccAzureRMCdnEndpointCustomDomainPreCheck(t *testing.T) (string, string) {
+	zoneNameEnvVariable := "ARM_TEST_DNS_ZONE_NAME"
+	zoneName := os.Getenv(zoneNameEnvVariable)
+	if zoneName == "" {
+		t.Skipf("Skipping as %q is not specified", zoneNameEnvVariable)
+	}
+
+	zoneResourceGroupEnvVariable := "ARM_TEST_DNS_ZONE_RESOURCE_GROUP_NAME"
+	zoneResourceGroup := os.Getenv(zoneResourceGroupEnvVariable)
+	if zoneResourceGroup == "" {
+		t.Skipf("Skipping as %q is not specified", zoneResourceGroupEnvVariable)
+	}
+
+	return zoneName, zoneResourceGroup
+}
+
+func TestAccAzureRMCdnEndpointCustomDomain_basic(t *testing.T) {
+	zoneName, zoneResourceGroup := testAccAzureRMCdnEndpointCustomDomainPreCheck(t)
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_basic(ri, testLocation(), zoneName, zoneResourceGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "host_name", fmt.Sprintf("acctestcdn%d.%s", ri, zoneName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCdnEndpointCustomDomain_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	zoneName, zoneResourceGroup := testAccAzureRMCdnEndpointCustomDomainPreCheck(t)
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_basic(ri, location, zoneName, zoneResourceGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCdnEndpointCustomDomain_requiresImport(ri, location, zoneName, zoneResourceGroup),
+				ExpectError: testRequiresImportError("azurerm_cdn_endpoint_custom_domain"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCdnEndpointCustomDomain_cdnManagedHttps(t *testing.T) {
+	zoneName, zoneResourceGroup := testAccAzureRMCdnEndpointCustomDomainPreCheck(t)
+	resourceName := "azurerm_cdn_endpoint_custom_domain.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointCustomDomainDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_basic(ri, location, zoneName, zoneResourceGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https.#", "0"),
+				),
+			},
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_cdnManagedHttps(ri, location, zoneName, zoneResourceGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https.0.certificate_type", "Dedicated"),
+				),
+			},
+			{
+				Config: testAccAzureRMCdnEndpointCustomDomain_basic(ri, location, zoneName, zoneResourceGroup),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointCustomDomainExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cdn_managed_https.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMCdnEndpointCustomDomainExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).cdn.CustomDomainsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		endpointName := rs.Primary.Attributes["endpoint_name"]
+		profileName := rs.Primary.Attributes["profile_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for CDN Endpoint Custom Domain: %s", name)
+		}
+
+		resp, err := client.Get(ctx, resourceGroup, profileName, endpointName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: CDN Endpoint Custom Domain %q (Endpoint %q / Profile %q / Resource Group %q) does not exist", name, endpointName, profileName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on cdnCustomDomainsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCdnEndpointCustomDomainDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).cdn.CustomDomainsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cdn_endpoint_custom_domain" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Path["profiles"], id.Path["endpoints"], id.Path["customDomains"])
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("CDN Endpoint Custom Domain still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_template(rInt int, location, zoneName, zoneResourceGroup string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%[1]d"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name      = "acceptanceTestCdnOrigin1"
+    host_name = "www.example.com"
+  }
+}
+
+resource "azurerm_dns_cname_record" "test" {
+  name                = "acctestcdn%[1]d"
+  zone_name           = "%[3]s"
+  resource_group_name = "%[4]s"
+  ttl                 = 300
+  record              = "${azurerm_cdn_endpoint.test.host_name}"
+}
+`, rInt, location, zoneName, zoneResourceGroup)
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_basic(rInt int, location, zoneName, zoneResourceGroup string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_endpoint_custom_domain" "test" {
+  name                = "acctestcdndomain%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  endpoint_name       = "${azurerm_cdn_endpoint.test.name}"
+  host_name           = "${azurerm_dns_cname_record.test.name}.%s"
+}
+`, testAccAzureRMCdnEndpointCustomDomain_template(rInt, location, zoneName, zoneResourceGroup), rInt, zoneName)
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_requiresImport(rInt int, location, zoneName, zoneResourceGroup string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_endpoint_custom_domain" "import" {
+  name                = "${azurerm_cdn_endpoint_custom_domain.test.name}"
+  resource_group_name = "${azurerm_cdn_endpoint_custom_domain.test.resource_group_name}"
+  profile_name        = "${azurerm_cdn_endpoint_custom_domain.test.profile_name}"
+  endpoint_name       = "${azurerm_cdn_endpoint_custom_domain.test.endpoint_name}"
+  host_name           = "${azurerm_cdn_endpoint_custom_domain.test.host_name}"
+}
+`, testAccAzureRMCdnEndpointCustomDomain_basic(rInt, location, zoneName, zoneResourceGroup))
+}
+
+func testAccAzureRMCdnEndpointCustomDomain_cdnManagedHttps(rInt int, location, zoneName, zoneResourceGroup string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_endpoint_custom_domain" "test" {
+  name                = "acctestcdndomain%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  endpoint_name       = "${azurerm_cdn_endpoint.test.name}"
+  host_name           = "${azurerm_dns_cname_record.test.name}.%s"
+
+  cdn_managed_https {
+    certificate_type = "Dedicated"
+    protocol_type    = "ServerNameIndication"
+  }
+}
+`, testAccAzureRMCdnEndpointCustomDomain_template(rInt, location, zoneName, zoneResourceGroup), rInt, zoneName)
+}
@@ -200,6 +200,44 @@ func TestAccAzureRMCdnEndpoint_withGeoFilters(t *testing.T) {
 		},
 	})
 }
+func TestAccAzureRMCdnEndpoint_withDeliveryRules(t *testing.T) {
+	resourceName := "azurerm_cdn_endpoint.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMCdnEndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCdnEndpoint_deliveryRules(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "global_delivery_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "global_delivery_rule.0.cache_expiration_action.0.behavior", "Override"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.0.url_path_condition.0.path", "/images/*"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.1.url_file_extension_condition.0.extensions.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMCdnEndpoint_basicMicrosoft(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCdnEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "global_delivery_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "delivery_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMCdnEndpoint_fullFields(t *testing.T) {
 	resourceName := "azurerm_cdn_endpoint.test"
 	ri := tf.AccRandTimeInt()
@@ -554,6 +592,99 @@ resource "azurerm_cdn_endpoint" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMCdnEndpoint_basicMicrosoft(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.example.com"
+    https_port = 443
+    http_port  = 80
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMCdnEndpoint_deliveryRules(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_cdn_profile" "test" {
+  name                = "acctestcdnprof%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard_Microsoft"
+}
+
+resource "azurerm_cdn_endpoint" "test" {
+  name                = "acctestcdnend%d"
+  profile_name        = "${azurerm_cdn_profile.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  origin {
+    name       = "acceptanceTestCdnOrigin1"
+    host_name  = "www.example.com"
+    https_port = 443
+    http_port  = 80
+  }
+
+  global_delivery_rule {
+    cache_expiration_action {
+      behavior = "Override"
+      duration = "5.04:44:23"
+    }
+  }
+
+  delivery_rule {
+    order = 1
+
+    url_path_condition {
+      match_type = "Wildcard"
+      path       = "/images/*"
+    }
+
+    cache_expiration_action {
+      behavior = "SetIfMissing"
+      duration = "12:00:00"
+    }
+  }
+
+  delivery_rule {
+    order = 2
+
+    url_file_extension_condition {
+      extensions = ["css", "js"]
+    }
+
+    cache_expiration_action {
+      behavior = "BypassCache"
+    }
+  }
+}
+`, rInt, location, rInt, rInt)
+}
+
 func testAccAzureRMCdnEndpoint_optimized(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -13,11 +13,10 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
-type cognitiveServicesPropertiesStruct struct{}
-
 func resourceArmCognitiveAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmCognitiveAccountCreate,
@@ -45,9 +44,11 @@ func resourceArmCognitiveAccount() *schema.Resource {
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"Academic",
+					"AnomalyDetector",
 					"Bing.Autosuggest",
 					"Bing.Autosuggest.v7",
 					"Bing.CustomSearch",
+					"Bing.EntitySearch",
 					"Bing.Search",
 					"Bing.Search.v7",
 					"Bing.Speech",
@@ -61,7 +62,10 @@ func resourceArmCognitiveAccount() *schema.Resource {
 					"CustomVision.Training",
 					"Emotion",
 					"Face",
+					"FormRecognizer",
+					"ImmersiveReader",
 					"LUIS",
+					"Personalizer",
 					"QnAMaker",
 					"Recommendations",
 					"SpeakerRecognition",
@@ -101,6 +105,51 @@ func resourceArmCognitiveAccount() *schema.Resource {
 				},
 			},
 
+			"custom_subdomain_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"network_acls": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"default_action": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(cognitiveservices.Allow),
+								string(cognitiveservices.Deny),
+							}, false),
+						},
+
+						"ip_rules": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.CIDR,
+							},
+							Set: schema.HashString,
+						},
+
+						"virtual_network_subnet_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: azure.ValidateResourceID,
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 
 			"endpoint": {
@@ -125,7 +174,8 @@ func resourceArmCognitiveAccount() *schema.Resource {
 
 func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitive.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -148,11 +198,16 @@ func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{})
 	t := d.Get("tags").(map[string]interface{})
 	sku := expandCognitiveAccountSku(d)
 
+	accountProperties, err := expandCognitiveAccountProperties(d)
+	if err != nil {
+		return err
+	}
+
 	properties := cognitiveservices.AccountCreateParameters{
 		Kind:       utils.String(kind),
 		Location:   utils.String(location),
 		Sku:        sku,
-		Properties: &cognitiveServicesPropertiesStruct{},
+		Properties: accountProperties,
 		Tags:       tags.Expand(t),
 	}
 
@@ -172,7 +227,8 @@ func resourceArmCognitiveAccountCreate(d *schema.ResourceData, meta interface{})
 
 func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitive.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -185,9 +241,24 @@ func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{})
 	t := d.Get("tags").(map[string]interface{})
 	sku := expandCognitiveAccountSku(d)
 
+	accountProperties, err := expandCognitiveAccountProperties(d)
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("network_acls") && accountProperties.NetworkAcls == nil {
+		// the API leaves the existing rules in place when `networkAcls` is omitted, so these need to be reset
+		accountProperties.NetworkAcls = &cognitiveservices.NetworkRuleSet{
+			DefaultAction:       cognitiveservices.Allow,
+			IPRules:             &[]cognitiveservices.IPRule{},
+			VirtualNetworkRules: &[]cognitiveservices.VirtualNetworkRule{},
+		}
+	}
+
 	properties := cognitiveservices.AccountUpdateParameters{
-		Sku:  sku,
-		Tags: tags.Expand(t),
+		Sku:        sku,
+		Properties: accountProperties,
+		Tags:       tags.Expand(t),
 	}
 
 	_, err = client.Update(ctx, resourceGroup, name, properties)
@@ -200,7 +271,8 @@ func resourceArmCognitiveAccountUpdate(d *schema.ResourceData, meta interface{})
 
 func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitive.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -235,6 +307,11 @@ func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) e
 
 	if props := resp.AccountProperties; props != nil {
 		d.Set("endpoint", props.Endpoint)
+		d.Set("custom_subdomain_name", props.CustomSubDomainName)
+
+		if err := d.Set("network_acls", flattenCognitiveAccountNetworkAcls(props.NetworkAcls)); err != nil {
+			return fmt.Errorf("Error setting `network_acls`: %+v", err)
+		}
 	}
 
 	keys, err := client.ListKeys(ctx, resourceGroup, name)
@@ -257,7 +334,8 @@ func resourceArmCognitiveAccountRead(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmCognitiveAccountDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).cognitive.AccountsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -302,3 +380,85 @@ func flattenCognitiveAccountSku(input *cognitiveservices.Sku) []interface{} {
 
 	return []interface{}{m}
 }
+
+func expandCognitiveAccountProperties(d *schema.ResourceData) (*cognitiveservices.AccountProperties, error) {
+	properties := cognitiveservices.AccountProperties{}
+
+	customSubDomainName := d.Get("custom_subdomain_name").(string)
+	if customSubDomainName != "" {
+		properties.CustomSubDomainName = utils.String(customSubDomainName)
+	}
+
+	networkAclsRaw := d.Get("network_acls").([]interface{})
+	if len(networkAclsRaw) > 0 {
+		// the network rules are only applied to requests made to the custom subdomain
+		if customSubDomainName == "" {
+			return nil, fmt.Errorf("`custom_subdomain_name` must be set when `network_acls` is specified")
+		}
+
+		properties.NetworkAcls = expandCognitiveAccountNetworkAcls(networkAclsRaw)
+	}
+
+	return &properties, nil
+}
+
+func expandCognitiveAccountNetworkAcls(input []interface{}) *cognitiveservices.NetworkRuleSet {
+	v := input[0].(map[string]interface{})
+
+	ipRules := make([]cognitiveservices.IPRule, 0)
+	for _, ipRule := range v["ip_rules"].(*schema.Set).List() {
+		ipRules = append(ipRules, cognitiveservices.IPRule{
+			Value: utils.String(ipRule.(string)),
+		})
+	}
+
+	virtualNetworkRules := make([]cognitiveservices.VirtualNetworkRule, 0)
+	for _, subnetId := range v["virtual_network_subnet_ids"].(*schema.Set).List() {
+		virtualNetworkRules = append(virtualNetworkRules, cognitiveservices.VirtualNetworkRule{
+			ID: utils.String(subnetId.(string)),
+		})
+	}
+
+	return &cognitiveservices.NetworkRuleSet{
+		DefaultAction:       cognitiveservices.NetworkRuleAction(v["default_action"].(string)),
+		IPRules:             &ipRules,
+		VirtualNetworkRules: &virtualNetworkRules,
+	}
+}
+
+func flattenCognitiveAccountNetworkAcls(input *cognitiveservices.NetworkRuleSet) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	ipRules := make([]interface{}, 0)
+	if input.IPRules != nil {
+		for _, v := range *input.IPRules {
+			if v.Value != nil {
+				ipRules = append(ipRules, *v.Value)
+			}
+		}
+	}
+
+	virtualNetworkSubnetIds := make([]interface{}, 0)
+	if input.VirtualNetworkRules != nil {
+		for _, v := range *input.VirtualNetworkRules {
+			if v.ID != nil {
+				virtualNetworkSubnetIds = append(virtualNetworkSubnetIds, *v.ID)
+			}
+		}
+	}
+
+	// allowing all traffic without any rules is the same as not having any network rules
+	if input.DefaultAction == cognitiveservices.Allow && len(ipRules) == 0 && len(virtualNetworkSubnetIds) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"default_action":             string(input.DefaultAction),
+			"ip_rules":                   schema.NewSet(schema.HashString, ipRules),
+			"virtual_network_subnet_ids": schema.NewSet(schema.HashString, virtualNetworkSubnetIds),
+		},
+	}
+}
@@ -164,6 +164,42 @@ func TestAccAzureRMCognitiveAccount_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMCognitiveAccount_networkAcls(t *testing.T) {
+	resourceName := "azurerm_cognitive_account.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMAppCognitiveAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCognitiveAccount_networkAcls(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCognitiveAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_subdomain_name", fmt.Sprintf("acctestcogacc-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "network_acls.0.default_action", "Deny"),
+					resource.TestCheckResourceAttr(resourceName, "network_acls.0.ip_rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "network_acls.0.virtual_network_subnet_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMCognitiveAccount_networkAclsUpdated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCognitiveAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "network_acls.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMAppCognitiveAccountDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).cognitive.AccountsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -302,3 +338,73 @@ resource "azurerm_cognitive_account" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMCognitiveAccount_networkAclsTemplate(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsubnet%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+  service_endpoints    = ["Microsoft.CognitiveServices"]
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMCognitiveAccount_networkAcls(rInt int, location string) string {
+	template := testAccAzureRMCognitiveAccount_networkAclsTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account" "test" {
+  name                  = "acctestcogacc-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  kind                  = "Face"
+  custom_subdomain_name = "acctestcogacc-%d"
+
+  sku {
+    name = "S0"
+    tier = "Standard"
+  }
+
+  network_acls {
+    default_action             = "Deny"
+    ip_rules                   = ["123.0.0.101"]
+    virtual_network_subnet_ids = ["${azurerm_subnet.test.id}"]
+  }
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMCognitiveAccount_networkAclsUpdated(rInt int, location string) string {
+	template := testAccAzureRMCognitiveAccount_networkAclsTemplate(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_account" "test" {
+  name                  = "acctestcogacc-%d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  kind                  = "Face"
+  custom_subdomain_name = "acctestcogacc-%d"
+
+  sku {
+    name = "S0"
+    tier = "Standard"
+  }
+}
+`, template, rInt, rInt)
+}
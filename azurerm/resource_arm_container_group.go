@@ -21,6 +21,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -426,6 +427,47 @@ func resourceArmContainerGroup() *schema.Resource {
 				},
 			},
 
+			"dns_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"nameservers": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"search_domains": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"options": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+					},
+				},
+			},
+
 			"ip_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -441,7 +483,8 @@ func resourceArmContainerGroup() *schema.Resource {
 
 func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.GroupsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup := d.Get("resource_group_name").(string)
 	name := d.Get("name").(string)
@@ -484,6 +527,7 @@ func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) e
 			OsType:                   containerinstance.OperatingSystemTypes(OSType),
 			Volumes:                  containerGroupVolumes,
 			ImageRegistryCredentials: expandContainerImageRegistryCredentials(d),
+			DNSConfig:                expandContainerGroupDnsConfig(d.Get("dns_config").([]interface{})),
 		},
 	}
 
@@ -526,7 +570,8 @@ func resourceArmContainerGroupCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).containers.GroupsClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
@@ -581,13 +626,24 @@ func resourceArmContainerGroupRead(d *schema.ResourceData, meta interface{}) err
 		if err := d.Set("diagnostics", flattenContainerGroupDiagnostics(d, props.Diagnostics)); err != nil {
 			return fmt.Errorf("Error setting `diagnostics`: %+v", err)
 		}
+
+		networkProfileId := ""
+		if profile := props.NetworkProfile; profile != nil && profile.ID != nil {
+			networkProfileId = *profile.ID
+		}
+		d.Set("network_profile_id", networkProfileId)
+
+		if err := d.Set("dns_config", flattenContainerGroupDnsConfig(props.DNSConfig)); err != nil {
+			return fmt.Errorf("Error setting `dns_config`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceArmContainerGroupDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).containers.GroupsClient
 
 	id, err := azure.ParseAzureResourceID(d.Id())
@@ -1430,6 +1486,57 @@ func flattenContainerGroupDiagnostics(d *schema.ResourceData, input *containerin
 	}
 }
 
+func expandContainerGroupDnsConfig(input []interface{}) *containerinstance.DNSConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	config := containerinstance.DNSConfiguration{
+		NameServers: utils.ExpandStringSlice(v["nameservers"].([]interface{})),
+	}
+
+	// the API expects both the search domains and the options as a single space-separated string
+	if searchDomains := *utils.ExpandStringSlice(v["search_domains"].([]interface{})); len(searchDomains) > 0 {
+		config.SearchDomains = utils.String(strings.Join(searchDomains, " "))
+	}
+
+	if options := *utils.ExpandStringSlice(v["options"].([]interface{})); len(options) > 0 {
+		config.Options = utils.String(strings.Join(options, " "))
+	}
+
+	return &config
+}
+
+func flattenContainerGroupDnsConfig(input *containerinstance.DNSConfiguration) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	searchDomains := make([]interface{}, 0)
+	if input.SearchDomains != nil {
+		for _, v := range strings.Fields(*input.SearchDomains) {
+			searchDomains = append(searchDomains, v)
+		}
+	}
+
+	options := make([]interface{}, 0)
+	if input.Options != nil {
+		for _, v := range strings.Fields(*input.Options) {
+			options = append(options, v)
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"nameservers":    utils.FlattenStringSlice(input.NameServers),
+			"search_domains": searchDomains,
+			"options":        options,
+		},
+	}
+}
+
 func resourceArmContainerGroupPortsHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -408,8 +408,16 @@ func TestAccAzureRMContainerGroup_virtualNetwork(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "container.0.port", "80"),
 					resource.TestCheckResourceAttr(resourceName, "ip_address_type", "Private"),
 					resource.TestCheckResourceAttrSet(resourceName, "network_profile_id"),
+					resource.TestCheckResourceAttr(resourceName, "dns_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dns_config.0.nameservers.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "dns_config.0.search_domains.#", "1"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -928,6 +936,12 @@ resource "azurerm_container_group" "test" {
     port   = 80
   }
 
+  dns_config {
+    nameservers    = ["reddog.microsoft.com", "somecompany.somedomain"]
+    search_domains = ["default.svc.cluster.local"]
+    options        = ["ndots:2"]
+  }
+
   tags = {
     environment = "Testing"
   }
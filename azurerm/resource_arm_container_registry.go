@@ -1,13 +1,17 @@
 package azurerm
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
-
+	"sort"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2018-09-01/containerregistry"
+	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
@@ -17,6 +21,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -64,9 +69,11 @@ func resourceArmContainerRegistry() *schema.Resource {
 			},
 
 			"georeplication_locations": {
-				Type:     schema.TypeSet,
-				MinItems: 1,
-				Optional: true,
+				Type:          schema.TypeSet,
+				MinItems:      1,
+				Optional:      true,
+				Deprecated:    "`georeplication_locations` has been deprecated in favour of the `georeplications` block.",
+				ConflictsWith: []string{"georeplications"},
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
 					ValidateFunc: validate.NoEmptyStrings,
@@ -74,9 +81,32 @@ func resourceArmContainerRegistry() *schema.Resource {
 				Set: azure.HashAzureLocation,
 			},
 
+			"georeplications": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConfigMode:    schema.SchemaConfigModeAttr, // make sure we can set this to an empty array for Premium -> Basic
+				ConflictsWith: []string{"georeplication_locations"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"location": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validate.NoEmptyStrings,
+							StateFunc:        azure.NormalizeLocation,
+							DiffSuppressFunc: azure.SuppressLocationDiff,
+						},
+
+						"tags": tags.Schema(),
+					},
+				},
+				Set: resourceArmContainerRegistryGeoReplicationHash,
+			},
+
 			"storage_account_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceIDOfTypeOrEmpty("Microsoft.Storage/storageAccounts"),
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"storage_account": {
@@ -159,17 +189,47 @@ func resourceArmContainerRegistry() *schema.Resource {
 				},
 			},
 
+			"quarantine_policy_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"trust_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 
 		CustomizeDiff: func(d *schema.ResourceDiff, v interface{}) error {
 			sku := d.Get("sku").(string)
 			geoReplicationLocations := d.Get("georeplication_locations").(*schema.Set)
+			geoReplications := d.Get("georeplications").(*schema.Set)
+			hasGeoReplications := (geoReplicationLocations != nil && geoReplicationLocations.Len() > 0) || (geoReplications != nil && geoReplications.Len() > 0)
 			// if locations have been specified for geo-replication then, the SKU has to be Premium
-			if geoReplicationLocations != nil && geoReplicationLocations.Len() > 0 && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+			if hasGeoReplications && !strings.EqualFold(sku, string(containerregistry.Premium)) {
 				return fmt.Errorf("ACR geo-replication can only be applied when using the Premium Sku.")
 			}
 
+			// quarantine and content trust policies are only supported by the Premium Sku
+			policies := expandContainerRegistryPolicies(d.Get("quarantine_policy_enabled").(bool), d.Get("trust_policy").([]interface{}))
+			if containerRegistryPoliciesEnabled(policies) && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+				return fmt.Errorf("`quarantine_policy_enabled` and `trust_policy` can only be enabled when using the Premium Sku.")
+			}
+
 			return nil
 		},
 	}
@@ -177,7 +237,8 @@ func resourceArmContainerRegistry() *schema.Resource {
 
 func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.RegistriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Container Registry creation.")
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -200,7 +261,10 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 	sku := d.Get("sku").(string)
 	adminUserEnabled := d.Get("admin_enabled").(bool)
 	t := d.Get("tags").(map[string]interface{})
-	geoReplicationLocations := d.Get("georeplication_locations").(*schema.Set)
+	geoReplications := expandContainerRegistryGeoReplications(d.Get("georeplications").(*schema.Set).List())
+	if v, ok := d.GetOk("georeplication_locations"); ok {
+		geoReplications = expandContainerRegistryGeoReplicationLocations(v.(*schema.Set).List())
+	}
 
 	networkRuleSet := expandNetworkRuleSet(d.Get("network_rule_set").([]interface{}))
 	if networkRuleSet != nil && !strings.EqualFold(sku, string(containerregistry.Premium)) {
@@ -244,11 +308,17 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error waiting for creation of Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	policies := expandContainerRegistryPolicies(d.Get("quarantine_policy_enabled").(bool), d.Get("trust_policy").([]interface{}))
+	if containerRegistryPoliciesEnabled(policies) {
+		if err := updateContainerRegistryPolicies(ctx, client, resourceGroup, name, policies); err != nil {
+			return err
+		}
+	}
+
 	// locations have been specified for geo-replication
-	if geoReplicationLocations != nil && geoReplicationLocations.Len() > 0 {
+	if len(geoReplications) > 0 {
 		// the ACR is being created so no previous geo-replication locations
-		oldGeoReplicationLocations := []interface{}{}
-		err = applyGeoReplicationLocations(meta, resourceGroup, name, oldGeoReplicationLocations, geoReplicationLocations.List())
+		err = applyContainerRegistryGeoReplications(meta, resourceGroup, name, []containerregistry.Replication{}, geoReplications)
 		if err != nil {
 			return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
@@ -270,7 +340,8 @@ func resourceArmContainerRegistryCreate(d *schema.ResourceData, meta interface{}
 
 func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.RegistriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for AzureRM Container Registry update.")
 
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -280,10 +351,18 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 	adminUserEnabled := d.Get("admin_enabled").(bool)
 	t := d.Get("tags").(map[string]interface{})
 
-	old, new := d.GetChange("georeplication_locations")
-	hasGeoReplicationChanges := d.HasChange("georeplication_locations")
-	oldGeoReplicationLocations := old.(*schema.Set)
-	newGeoReplicationLocations := new.(*schema.Set)
+	// the existing replications are tracked in whichever field is in use, so both are needed to determine what's deployed
+	hasGeoReplicationChanges := d.HasChange("georeplication_locations") || d.HasChange("georeplications")
+	oldLocationsRaw, _ := d.GetChange("georeplication_locations")
+	oldReplicationsRaw, newReplicationsRaw := d.GetChange("georeplications")
+	oldGeoReplications := append(expandContainerRegistryGeoReplicationLocations(oldLocationsRaw.(*schema.Set).List()), expandContainerRegistryGeoReplications(oldReplicationsRaw.(*schema.Set).List())...)
+
+	// `georeplication_locations` is deprecated, so it's only used whilst it's still set in the config - otherwise the
+	// `georeplications` block is used, which means both fields can be swapped over in a single apply
+	newGeoReplications := expandContainerRegistryGeoReplications(newReplicationsRaw.(*schema.Set).List())
+	if v, ok := d.GetOk("georeplication_locations"); ok {
+		newGeoReplications = expandContainerRegistryGeoReplicationLocations(v.(*schema.Set).List())
+	}
 
 	networkRuleSet := expandNetworkRuleSet(d.Get("network_rule_set").([]interface{}))
 	if networkRuleSet != nil && !strings.EqualFold(sku, string(containerregistry.Premium)) {
@@ -317,13 +396,13 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 	}
 
 	// geo replication is only supported by Premium Sku
-	if hasGeoReplicationChanges && newGeoReplicationLocations.Len() > 0 && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+	if hasGeoReplicationChanges && len(newGeoReplications) > 0 && !strings.EqualFold(sku, string(containerregistry.Premium)) {
 		return fmt.Errorf("ACR geo-replication can only be applied when using the Premium Sku.")
 	}
 
 	// if the registry had replications and is updated to another Sku than premium - remove old locations
-	if !strings.EqualFold(sku, string(containerregistry.Premium)) && oldGeoReplicationLocations != nil && oldGeoReplicationLocations.Len() > 0 {
-		err := applyGeoReplicationLocations(meta, resourceGroup, name, oldGeoReplicationLocations.List(), newGeoReplicationLocations.List())
+	if !strings.EqualFold(sku, string(containerregistry.Premium)) && len(oldGeoReplications) > 0 {
+		err := applyContainerRegistryGeoReplications(meta, resourceGroup, name, oldGeoReplications, newGeoReplications)
 		if err != nil {
 			return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
@@ -338,8 +417,15 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error waiting for update of Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	if strings.EqualFold(sku, string(containerregistry.Premium)) && (d.HasChange("quarantine_policy_enabled") || d.HasChange("trust_policy")) {
+		policies := expandContainerRegistryPolicies(d.Get("quarantine_policy_enabled").(bool), d.Get("trust_policy").([]interface{}))
+		if err := updateContainerRegistryPolicies(ctx, client, resourceGroup, name, policies); err != nil {
+			return err
+		}
+	}
+
 	if strings.EqualFold(sku, string(containerregistry.Premium)) && hasGeoReplicationChanges {
-		err = applyGeoReplicationLocations(meta, resourceGroup, name, oldGeoReplicationLocations.List(), newGeoReplicationLocations.List())
+		err = applyContainerRegistryGeoReplications(meta, resourceGroup, name, oldGeoReplications, newGeoReplications)
 		if err != nil {
 			return fmt.Errorf("Error applying geo replications for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
@@ -359,69 +445,53 @@ func resourceArmContainerRegistryUpdate(d *schema.ResourceData, meta interface{}
 	return resourceArmContainerRegistryRead(d, meta)
 }
 
-func applyGeoReplicationLocations(meta interface{}, resourceGroup string, name string, oldGeoReplicationLocations []interface{}, newGeoReplicationLocations []interface{}) error {
+func applyContainerRegistryGeoReplications(meta interface{}, resourceGroup string, name string, oldGeoReplications []containerregistry.Replication, newGeoReplications []containerregistry.Replication) error {
 	replicationClient := meta.(*ArmClient).containers.ReplicationsClient
 	ctx := meta.(*ArmClient).StopContext
 	log.Printf("[INFO] preparing to apply geo-replications for AzureRM Container Registry.")
 
-	createLocations := make(map[string]bool)
-
-	// loop on the new location values
-	for _, nl := range newGeoReplicationLocations {
-		newLocation := azure.NormalizeLocation(nl)
-		createLocations[newLocation] = true // the location needs to be created
+	existing := make(map[string]containerregistry.Replication)
+	for _, replication := range oldGeoReplications {
+		existing[azure.NormalizeLocation(*replication.Location)] = replication
 	}
 
-	// loop on the old location values
-	for _, ol := range oldGeoReplicationLocations {
-		// oldLocation was created from a previous deployment
-		oldLocation := azure.NormalizeLocation(ol)
+	desired := make(map[string]bool)
+	for _, replication := range newGeoReplications {
+		location := azure.NormalizeLocation(*replication.Location)
+		desired[location] = true
 
-		// if the list of locations to create already contains the location
-		if _, ok := createLocations[oldLocation]; ok {
-			createLocations[oldLocation] = false // the location do not need to be created, it already exists
-		}
-	}
-
-	// create new geo-replication locations
-	for locationToCreate := range createLocations {
-		// if false, the location does not need to be created, continue
-		if !createLocations[locationToCreate] {
+		// the replication already exists with the same tags, nothing to do
+		if v, ok := existing[location]; ok && reflect.DeepEqual(tags.Flatten(v.Tags), tags.Flatten(replication.Tags)) {
 			continue
 		}
 
-		// create the new replication location
-		replication := containerregistry.Replication{
-			Location: &locationToCreate,
-			Name:     &locationToCreate,
-		}
+		// create (or update the tags of) the replication location
+		replication.Location = utils.String(location)
+		replication.Name = utils.String(location)
 
-		future, err := replicationClient.Create(ctx, resourceGroup, name, locationToCreate, replication)
+		future, err := replicationClient.Create(ctx, resourceGroup, name, location, replication)
 		if err != nil {
-			return fmt.Errorf("Error creating Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, locationToCreate, err)
+			return fmt.Errorf("Error creating Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, location, err)
 		}
 
 		if err = future.WaitForCompletionRef(ctx, replicationClient.Client); err != nil {
-			return fmt.Errorf("Error waiting for creation of Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, locationToCreate, err)
+			return fmt.Errorf("Error waiting for creation of Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, location, err)
 		}
 	}
 
-	// loop on the list of previously deployed locations
-	for _, ol := range oldGeoReplicationLocations {
-		oldLocation := azure.NormalizeLocation(ol)
-		// if the old location is still in the list of locations, then continue
-		if _, ok := createLocations[oldLocation]; ok {
+	// delete the previously deployed locations which are no longer in the list of locations
+	for location := range existing {
+		if desired[location] {
 			continue
 		}
 
-		// the old location is not in the list of locations, delete it
-		future, err := replicationClient.Delete(ctx, resourceGroup, name, oldLocation)
+		future, err := replicationClient.Delete(ctx, resourceGroup, name, location)
 		if err != nil {
-			return fmt.Errorf("Error deleting Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, oldLocation, err)
+			return fmt.Errorf("Error deleting Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, location, err)
 		}
 
 		if err = future.WaitForCompletionRef(ctx, replicationClient.Client); err != nil {
-			return fmt.Errorf("Error waiting for deletion of Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, oldLocation, err)
+			return fmt.Errorf("Error waiting for deletion of Container Registry Replication %q (Resource Group %q, Location %q): %+v", name, resourceGroup, location, err)
 		}
 	}
 
@@ -431,7 +501,8 @@ func applyGeoReplicationLocations(meta interface{}, resourceGroup string, name s
 func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.RegistriesClient
 	replicationClient := meta.(*ArmClient).containers.ReplicationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -468,6 +539,22 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 
 	if sku := resp.Sku; sku != nil {
 		d.Set("sku", string(sku.Tier))
+
+		// policies are only available for the Premium Sku
+		quarantinePolicyEnabled := false
+		trustPolicy := make([]interface{}, 0)
+		if strings.EqualFold(string(sku.Tier), string(containerregistry.Premium)) {
+			policies, err := client.ListPolicies(ctx, resourceGroup, name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Policies for Azure Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+
+			quarantinePolicyEnabled, trustPolicy = flattenContainerRegistryPolicies(policies)
+		}
+		d.Set("quarantine_policy_enabled", quarantinePolicyEnabled)
+		if err := d.Set("trust_policy", trustPolicy); err != nil {
+			return fmt.Errorf("Error setting `trust_policy`: %+v", err)
+		}
 	}
 
 	if account := resp.StorageAccount; account != nil {
@@ -500,17 +587,28 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 	// if there is more than one location (the main one and the replicas)
 	if replicationValues != nil || len(replicationValues) > 1 {
 		georeplication_locations := &schema.Set{F: schema.HashString}
+		geoReplications := make([]interface{}, 0)
 
 		for _, value := range replicationValues {
 			if value.Location != nil {
 				valueLocation := azure.NormalizeLocation(*value.Location)
 				if location != nil && valueLocation != azure.NormalizeLocation(*location) {
 					georeplication_locations.Add(valueLocation)
+					geoReplications = append(geoReplications, map[string]interface{}{
+						"location": valueLocation,
+						"tags":     tags.Flatten(value.Tags),
+					})
 				}
 			}
 		}
 
-		d.Set("georeplication_locations", georeplication_locations)
+		// `georeplication_locations` is deprecated, so it's only populated when it's already in use - since the
+		// two fields conflict, `georeplications` is only populated otherwise
+		if _, ok := d.GetOk("georeplication_locations"); ok {
+			d.Set("georeplication_locations", georeplication_locations)
+		} else if err := d.Set("georeplications", geoReplications); err != nil {
+			return fmt.Errorf("Error setting `georeplications`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -518,7 +616,8 @@ func resourceArmContainerRegistryRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmContainerRegistryDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.RegistriesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -615,3 +714,109 @@ func flattenNetworkRuleSet(networkRuleSet *containerregistry.NetworkRuleSet) []i
 
 	return []interface{}{values}
 }
+
+func expandContainerRegistryPolicies(quarantinePolicyEnabled bool, trustPolicies []interface{}) containerregistry.RegistryPolicies {
+	quarantineStatus := containerregistry.Disabled
+	if quarantinePolicyEnabled {
+		quarantineStatus = containerregistry.Enabled
+	}
+
+	trustStatus := containerregistry.Disabled
+	if len(trustPolicies) > 0 && trustPolicies[0] != nil {
+		v := trustPolicies[0].(map[string]interface{})
+		if v["enabled"].(bool) {
+			trustStatus = containerregistry.Enabled
+		}
+	}
+
+	return containerregistry.RegistryPolicies{
+		QuarantinePolicy: &containerregistry.QuarantinePolicy{
+			Status: quarantineStatus,
+		},
+		TrustPolicy: &containerregistry.TrustPolicy{
+			Type:   containerregistry.Notary,
+			Status: trustStatus,
+		},
+	}
+}
+
+func containerRegistryPoliciesEnabled(input containerregistry.RegistryPolicies) bool {
+	quarantineEnabled := input.QuarantinePolicy != nil && input.QuarantinePolicy.Status == containerregistry.Enabled
+	trustEnabled := input.TrustPolicy != nil && input.TrustPolicy.Status == containerregistry.Enabled
+	return quarantineEnabled || trustEnabled
+}
+
+func flattenContainerRegistryPolicies(input containerregistry.RegistryPolicies) (bool, []interface{}) {
+	quarantinePolicyEnabled := false
+	if policy := input.QuarantinePolicy; policy != nil {
+		quarantinePolicyEnabled = strings.EqualFold(string(policy.Status), string(containerregistry.Enabled))
+	}
+
+	trustPolicy := make([]interface{}, 0)
+	if policy := input.TrustPolicy; policy != nil {
+		trustPolicy = append(trustPolicy, map[string]interface{}{
+			"enabled": strings.EqualFold(string(policy.Status), string(containerregistry.Enabled)),
+		})
+	}
+
+	return quarantinePolicyEnabled, trustPolicy
+}
+
+func updateContainerRegistryPolicies(ctx context.Context, client *containerregistry.RegistriesClient, resourceGroup string, name string, policies containerregistry.RegistryPolicies) error {
+	future, err := client.UpdatePolicies(ctx, resourceGroup, name, policies)
+	if err != nil {
+		return fmt.Errorf("Error updating Policies for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Policies for Container Registry %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandContainerRegistryGeoReplicationLocations(input []interface{}) []containerregistry.Replication {
+	replications := make([]containerregistry.Replication, 0)
+	for _, v := range input {
+		replications = append(replications, containerregistry.Replication{
+			Location: utils.String(azure.NormalizeLocation(v.(string))),
+		})
+	}
+	return replications
+}
+
+func expandContainerRegistryGeoReplications(input []interface{}) []containerregistry.Replication {
+	replications := make([]containerregistry.Replication, 0)
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		v := raw.(map[string]interface{})
+		replications = append(replications, containerregistry.Replication{
+			Location: utils.String(azure.NormalizeLocation(v["location"].(string))),
+			Tags:     tags.Expand(v["tags"].(map[string]interface{})),
+		})
+	}
+	return replications
+}
+
+func resourceArmContainerRegistryGeoReplicationHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", azure.NormalizeLocation(m["location"].(string))))
+
+		if t, ok := m["tags"].(map[string]interface{}); ok {
+			keys := make([]string, 0, len(t))
+			for k := range t {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				buf.WriteString(fmt.Sprintf("%s=%v-", k, t[k]))
+			}
+		}
+	}
+
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,803 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/Azure/azure-sdk-for-go/services/containerregistry/mgmt/2018-09-01/containerregistry"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmContainerRegistryTask() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmContainerRegistryTaskCreateUpdate,
+		Read:   resourceArmContainerRegistryTaskRead,
+		Update: resourceArmContainerRegistryTaskCreateUpdate,
+		Delete: resourceArmContainerRegistryTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMContainerRegistryTaskName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"registry_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMContainerRegistryName,
+			},
+
+			"location": azure.SchemaLocation(),
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"platform": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"os": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.Linux),
+								string(containerregistry.Windows),
+							}, false),
+						},
+
+						"architecture": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(containerregistry.Amd64),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.Amd64),
+								string(containerregistry.Arm),
+								string(containerregistry.X86),
+							}, false),
+						},
+
+						"variant": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.V6),
+								string(containerregistry.V7),
+								string(containerregistry.V8),
+							}, false),
+						},
+					},
+				},
+			},
+
+			"agent_cpu": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"timeout_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3600,
+				ValidateFunc: validation.IntBetween(300, 28800),
+			},
+
+			"docker_step": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dockerfile_path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"context_path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"context_access_token": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"image_names": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.NoEmptyStrings,
+							},
+						},
+
+						"push_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"cache_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"target": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"arguments": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+
+						"secret_arguments": {
+							Type:      schema.TypeMap,
+							Optional:  true,
+							Sensitive: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+
+			"source_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"events": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(containerregistry.Commit),
+									string(containerregistry.Pullrequest),
+								}, false),
+							},
+						},
+
+						"source_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.Github),
+								string(containerregistry.VisualStudioTeamService),
+							}, false),
+						},
+
+						"repository_url": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"branch": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"authentication": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"token": {
+										Type:         schema.TypeString,
+										Required:     true,
+										Sensitive:    true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+
+									"token_type": {
+										Type:     schema.TypeString,
+										Required: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(containerregistry.PAT),
+											string(containerregistry.OAuth),
+										}, false),
+									},
+
+									"refresh_token": {
+										Type:      schema.TypeString,
+										Optional:  true,
+										Sensitive: true,
+									},
+
+									"scope": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"expire_in_seconds": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"base_image_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerregistry.All),
+								string(containerregistry.Runtime),
+							}, false),
+						},
+
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+
+			"tags": tags.Schema(),
+		},
+	}
+}
+
+func resourceArmContainerRegistryTaskCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containers.TasksClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	log.Printf("[INFO] preparing arguments for AzureRM Container Registry Task creation/update.")
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	registryName := d.Get("registry_name").(string)
+	name := d.Get("name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, registryName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Container Registry Task %q (Resource Group %q, Registry %q): %s", name, resourceGroup, registryName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_container_registry_task", *existing.ID)
+		}
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	t := d.Get("tags").(map[string]interface{})
+
+	status := containerregistry.TaskStatusDisabled
+	if d.Get("enabled").(bool) {
+		status = containerregistry.TaskStatusEnabled
+	}
+
+	properties := containerregistry.TaskProperties{
+		Status:   status,
+		Platform: expandContainerRegistryTaskPlatform(d.Get("platform").([]interface{})),
+		Timeout:  utils.Int32(int32(d.Get("timeout_in_seconds").(int))),
+		Step:     expandContainerRegistryTaskDockerStep(d.Get("docker_step").([]interface{})),
+		Trigger: &containerregistry.TriggerProperties{
+			SourceTriggers:   expandContainerRegistryTaskSourceTriggers(d.Get("source_trigger").([]interface{})),
+			BaseImageTrigger: expandContainerRegistryTaskBaseImageTrigger(d.Get("base_image_trigger").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("agent_cpu"); ok {
+		properties.AgentConfiguration = &containerregistry.AgentProperties{
+			CPU: utils.Int32(int32(v.(int))),
+		}
+	}
+
+	task := containerregistry.Task{
+		Location:       &location,
+		TaskProperties: &properties,
+		Tags:           tags.Expand(t),
+	}
+
+	future, err := client.Create(ctx, resourceGroup, registryName, name, task)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Container Registry Task %q (Resource Group %q, Registry %q): %+v", name, resourceGroup, registryName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Container Registry Task %q (Resource Group %q, Registry %q): %+v", name, resourceGroup, registryName, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, registryName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Container Registry Task %q (Resource Group %q, Registry %q): %+v", name, resourceGroup, registryName, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Container Registry Task %q (Resource Group %q, Registry %q) ID", name, resourceGroup, registryName)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmContainerRegistryTaskRead(d, meta)
+}
+
+func resourceArmContainerRegistryTaskRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containers.TasksClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	registryName := id.Path["registries"]
+	name := id.Path["tasks"]
+
+	resp, err := client.Get(ctx, resourceGroup, registryName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Container Registry Task %q was not found in Resource Group %q for Registry %q", name, resourceGroup, registryName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Azure Container Registry Task %q (Resource Group %q, Registry %q): %+v", name, resourceGroup, registryName, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("registry_name", registryName)
+	if location := resp.Location; location != nil {
+		d.Set("location", azure.NormalizeLocation(*location))
+	}
+
+	if props := resp.TaskProperties; props != nil {
+		d.Set("enabled", props.Status == containerregistry.TaskStatusEnabled)
+
+		if err := d.Set("platform", flattenContainerRegistryTaskPlatform(props.Platform)); err != nil {
+			return fmt.Errorf("Error setting `platform`: %+v", err)
+		}
+
+		if agent := props.AgentConfiguration; agent != nil && agent.CPU != nil {
+			d.Set("agent_cpu", int(*agent.CPU))
+		}
+
+		if props.Timeout != nil {
+			d.Set("timeout_in_seconds", int(*props.Timeout))
+		}
+
+		if err := d.Set("docker_step", flattenContainerRegistryTaskDockerStep(props.Step, d.Get("docker_step").([]interface{}))); err != nil {
+			return fmt.Errorf("Error setting `docker_step`: %+v", err)
+		}
+
+		var sourceTriggers []interface{}
+		var baseImageTrigger []interface{}
+		if trigger := props.Trigger; trigger != nil {
+			sourceTriggers = flattenContainerRegistryTaskSourceTriggers(trigger.SourceTriggers, d.Get("source_trigger").([]interface{}))
+			baseImageTrigger = flattenContainerRegistryTaskBaseImageTrigger(trigger.BaseImageTrigger)
+		}
+		if err := d.Set("source_trigger", sourceTriggers); err != nil {
+			return fmt.Errorf("Error setting `source_trigger`: %+v", err)
+		}
+		if err := d.Set("base_image_trigger", baseImageTrigger); err != nil {
+			return fmt.Errorf("Error setting `base_image_trigger`: %+v", err)
+		}
+	}
+
+	return tags.FlattenAndSet(d, resp.Tags)
+}
+
+func resourceArmContainerRegistryTaskDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).containers.TasksClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	registryName := id.Path["registries"]
+	name := id.Path["tasks"]
+
+	future, err := client.Delete(ctx, resourceGroup, registryName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Container Registry Task %q (Resource Group %q, Registry %q): %+v", name, resourceGroup, registryName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error waiting for deletion of Container Registry Task %q (Resource Group %q, Registry %q): %+v", name, resourceGroup, registryName, err)
+	}
+
+	return nil
+}
+
+func validateAzureRMContainerRegistryTaskName(v interface{}, k string) (warnings []string, errors []error) {
+	value := v.(string)
+	if !regexp.MustCompile(`^[a-zA-Z0-9_-]{5,50}$`).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be between 5 and 50 characters and may only contain alphanumeric characters, underscores and hyphens: %q", k, value))
+	}
+
+	return warnings, errors
+}
+
+func expandContainerRegistryTaskPlatform(input []interface{}) *containerregistry.PlatformProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &containerregistry.PlatformProperties{
+		Os:           containerregistry.OS(v["os"].(string)),
+		Architecture: containerregistry.Architecture(v["architecture"].(string)),
+		Variant:      containerregistry.Variant(v["variant"].(string)),
+	}
+}
+
+func expandContainerRegistryTaskDockerStep(input []interface{}) *containerregistry.DockerBuildStep {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	arguments := make([]containerregistry.Argument, 0)
+	for name, value := range v["arguments"].(map[string]interface{}) {
+		arguments = append(arguments, containerregistry.Argument{
+			Name:     utils.String(name),
+			Value:    utils.String(value.(string)),
+			IsSecret: utils.Bool(false),
+		})
+	}
+	for name, value := range v["secret_arguments"].(map[string]interface{}) {
+		arguments = append(arguments, containerregistry.Argument{
+			Name:     utils.String(name),
+			Value:    utils.String(value.(string)),
+			IsSecret: utils.Bool(true),
+		})
+	}
+
+	step := containerregistry.DockerBuildStep{
+		DockerFilePath: utils.String(v["dockerfile_path"].(string)),
+		ContextPath:    utils.String(v["context_path"].(string)),
+		ImageNames:     utils.ExpandStringSlice(v["image_names"].([]interface{})),
+		IsPushEnabled:  utils.Bool(v["push_enabled"].(bool)),
+		NoCache:        utils.Bool(!v["cache_enabled"].(bool)),
+		Arguments:      &arguments,
+		Type:           containerregistry.TypeDocker,
+	}
+
+	if token := v["context_access_token"].(string); token != "" {
+		step.ContextAccessToken = utils.String(token)
+	}
+
+	if target := v["target"].(string); target != "" {
+		step.Target = utils.String(target)
+	}
+
+	return &step
+}
+
+func expandContainerRegistryTaskSourceTriggers(input []interface{}) *[]containerregistry.SourceTrigger {
+	triggers := make([]containerregistry.SourceTrigger, 0)
+
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+
+		events := make([]containerregistry.SourceTriggerEvent, 0)
+		for _, event := range v["events"].(*schema.Set).List() {
+			events = append(events, containerregistry.SourceTriggerEvent(event.(string)))
+		}
+
+		status := containerregistry.TriggerStatusDisabled
+		if v["enabled"].(bool) {
+			status = containerregistry.TriggerStatusEnabled
+		}
+
+		source := containerregistry.SourceProperties{
+			SourceControlType:           containerregistry.SourceControlType(v["source_type"].(string)),
+			RepositoryURL:               utils.String(v["repository_url"].(string)),
+			SourceControlAuthProperties: expandContainerRegistryTaskAuthInfo(v["authentication"].([]interface{})),
+		}
+		if branch := v["branch"].(string); branch != "" {
+			source.Branch = utils.String(branch)
+		}
+
+		triggers = append(triggers, containerregistry.SourceTrigger{
+			Name:                utils.String(v["name"].(string)),
+			SourceRepository:    &source,
+			SourceTriggerEvents: &events,
+			Status:              status,
+		})
+	}
+
+	return &triggers
+}
+
+func expandContainerRegistryTaskAuthInfo(input []interface{}) *containerregistry.AuthInfo {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	info := containerregistry.AuthInfo{
+		Token:     utils.String(v["token"].(string)),
+		TokenType: containerregistry.TokenType(v["token_type"].(string)),
+	}
+
+	if refreshToken := v["refresh_token"].(string); refreshToken != "" {
+		info.RefreshToken = utils.String(refreshToken)
+	}
+	if scope := v["scope"].(string); scope != "" {
+		info.Scope = utils.String(scope)
+	}
+	if expiresIn := v["expire_in_seconds"].(int); expiresIn > 0 {
+		info.ExpiresIn = utils.Int32(int32(expiresIn))
+	}
+
+	return &info
+}
+
+func expandContainerRegistryTaskBaseImageTrigger(input []interface{}) *containerregistry.BaseImageTrigger {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	status := containerregistry.TriggerStatusDisabled
+	if v["enabled"].(bool) {
+		status = containerregistry.TriggerStatusEnabled
+	}
+
+	return &containerregistry.BaseImageTrigger{
+		Name:                 utils.String(v["name"].(string)),
+		BaseImageTriggerType: containerregistry.BaseImageTriggerType(v["type"].(string)),
+		Status:               status,
+	}
+}
+
+func flattenContainerRegistryTaskPlatform(input *containerregistry.PlatformProperties) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"os":           string(input.Os),
+			"architecture": string(input.Architecture),
+			"variant":      string(input.Variant),
+		},
+	}
+}
+
+func flattenContainerRegistryTaskDockerStep(input containerregistry.BasicTaskStepProperties, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	step, ok := input.AsDockerBuildStep()
+	if !ok || step == nil {
+		return []interface{}{}
+	}
+
+	// the API doesn't return the context access token or the values of secret arguments
+	// so we pull these through from the existing state
+	contextAccessToken := ""
+	secretArguments := make(map[string]interface{})
+	if len(existing) > 0 && existing[0] != nil {
+		v := existing[0].(map[string]interface{})
+		contextAccessToken = v["context_access_token"].(string)
+		secretArguments = v["secret_arguments"].(map[string]interface{})
+	}
+
+	dockerfilePath := ""
+	if step.DockerFilePath != nil {
+		dockerfilePath = *step.DockerFilePath
+	}
+
+	contextPath := ""
+	if step.ContextPath != nil {
+		contextPath = *step.ContextPath
+	}
+
+	pushEnabled := false
+	if step.IsPushEnabled != nil {
+		pushEnabled = *step.IsPushEnabled
+	}
+
+	cacheEnabled := true
+	if step.NoCache != nil {
+		cacheEnabled = !*step.NoCache
+	}
+
+	target := ""
+	if step.Target != nil {
+		target = *step.Target
+	}
+
+	arguments := make(map[string]interface{})
+	if step.Arguments != nil {
+		for _, arg := range *step.Arguments {
+			if arg.Name == nil || (arg.IsSecret != nil && *arg.IsSecret) {
+				continue
+			}
+
+			value := ""
+			if arg.Value != nil {
+				value = *arg.Value
+			}
+			arguments[*arg.Name] = value
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"dockerfile_path":      dockerfilePath,
+			"context_path":         contextPath,
+			"context_access_token": contextAccessToken,
+			"image_names":          utils.FlattenStringSlice(step.ImageNames),
+			"push_enabled":         pushEnabled,
+			"cache_enabled":        cacheEnabled,
+			"target":               target,
+			"arguments":            arguments,
+			"secret_arguments":     secretArguments,
+		},
+	}
+}
+
+func flattenContainerRegistryTaskSourceTriggers(input *[]containerregistry.SourceTrigger, existing []interface{}) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	// the API doesn't return the authentication details for a source trigger
+	// so we pull these through from the existing state, matching on the trigger name
+	existingAuthentication := make(map[string]interface{})
+	for _, item := range existing {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		existingAuthentication[v["name"].(string)] = v["authentication"]
+	}
+
+	for _, trigger := range *input {
+		name := ""
+		if trigger.Name != nil {
+			name = *trigger.Name
+		}
+
+		events := make([]interface{}, 0)
+		if trigger.SourceTriggerEvents != nil {
+			for _, event := range *trigger.SourceTriggerEvents {
+				events = append(events, string(event))
+			}
+		}
+
+		sourceType := ""
+		repositoryUrl := ""
+		branch := ""
+		if source := trigger.SourceRepository; source != nil {
+			sourceType = string(source.SourceControlType)
+			if source.RepositoryURL != nil {
+				repositoryUrl = *source.RepositoryURL
+			}
+			if source.Branch != nil {
+				branch = *source.Branch
+			}
+		}
+
+		authentication := make([]interface{}, 0)
+		if v, ok := existingAuthentication[name]; ok && v != nil {
+			authentication = v.([]interface{})
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":           name,
+			"events":         schema.NewSet(schema.HashString, events),
+			"source_type":    sourceType,
+			"repository_url": repositoryUrl,
+			"branch":         branch,
+			"enabled":        trigger.Status == containerregistry.TriggerStatusEnabled,
+			"authentication": authentication,
+		})
+	}
+
+	return results
+}
+
+func flattenContainerRegistryTaskBaseImageTrigger(input *containerregistry.BaseImageTrigger) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":    name,
+			"type":    string(input.BaseImageTriggerType),
+			"enabled": input.Status == containerregistry.TriggerStatusEnabled,
+		},
+	}
+}
@@ -0,0 +1,281 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMContainerRegistryTask_basic(t *testing.T) {
+	resourceName := "azurerm_container_registry_task.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMContainerRegistryTask_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "platform.0.os", "Linux"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMContainerRegistryTask_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_container_registry_task.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMContainerRegistryTask_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryTaskExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMContainerRegistryTask_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_container_registry_task"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMContainerRegistryTask_complete(t *testing.T) {
+	resourceName := "azurerm_container_registry_task.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryTaskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMContainerRegistryTask_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryTaskExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMContainerRegistryTask_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryTaskExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "timeout_in_seconds", "1800"),
+					resource.TestCheckResourceAttr(resourceName, "docker_step.0.arguments.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "base_image_trigger.0.type", "Runtime"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"docker_step.0.secret_arguments"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMContainerRegistryTaskDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).containers.TasksClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_container_registry_task" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		registryName := id.Path["registries"]
+		name := id.Path["tasks"]
+
+		resp, err := client.Get(ctx, resourceGroup, registryName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Container Registry Task %q (Resource Group %q, Registry %q) still exists", name, resourceGroup, registryName)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMContainerRegistryTaskExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).containers.TasksClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		registryName := id.Path["registries"]
+		name := id.Path["tasks"]
+
+		resp, err := client.Get(ctx, resourceGroup, registryName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Container Registry Task %q (Resource Group %q, Registry %q) does not exist", name, resourceGroup, registryName)
+			}
+
+			return fmt.Errorf("Bad: Get on TasksClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMContainerRegistryTask_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "acctestacr%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Standard"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMContainerRegistryTask_basic(rInt int, location string) string {
+	template := testAccAzureRMContainerRegistryTask_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                = "acctest-task-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  registry_name       = "${azurerm_container_registry.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  platform {
+    os = "Linux"
+  }
+
+  docker_step {
+    dockerfile_path = "Dockerfile"
+    context_path    = "https://github.com/Azure-Samples/acr-build-helloworld-node.git"
+    image_names     = ["helloworld:{{.Run.ID}}"]
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMContainerRegistryTask_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMContainerRegistryTask_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "import" {
+  name                = "${azurerm_container_registry_task.test.name}"
+  resource_group_name = "${azurerm_container_registry_task.test.resource_group_name}"
+  registry_name       = "${azurerm_container_registry_task.test.registry_name}"
+  location            = "${azurerm_container_registry_task.test.location}"
+
+  platform {
+    os = "Linux"
+  }
+
+  docker_step {
+    dockerfile_path = "Dockerfile"
+    context_path    = "https://github.com/Azure-Samples/acr-build-helloworld-node.git"
+    image_names     = ["helloworld:{{.Run.ID}}"]
+  }
+}
+`, template)
+}
+
+func testAccAzureRMContainerRegistryTask_complete(rInt int, location string) string {
+	template := testAccAzureRMContainerRegistryTask_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                = "acctest-task-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  registry_name       = "${azurerm_container_registry.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  enabled             = false
+  agent_cpu           = 2
+  timeout_in_seconds  = 1800
+
+  platform {
+    os           = "Linux"
+    architecture = "amd64"
+  }
+
+  docker_step {
+    dockerfile_path = "Dockerfile"
+    context_path    = "https://github.com/Azure-Samples/acr-build-helloworld-node.git"
+    image_names     = ["helloworld:{{.Run.ID}}", "helloworld:latest"]
+    push_enabled    = false
+    cache_enabled   = false
+
+    arguments = {
+      NODE_ENV = "production"
+    }
+
+    secret_arguments = {
+      SECRET = "s3cr3t"
+    }
+  }
+
+  base_image_trigger {
+    name = "base-image-trigger"
+    type = "Runtime"
+  }
+
+  tags = {
+    environment = "Production"
+  }
+}
+`, template, rInt)
+}
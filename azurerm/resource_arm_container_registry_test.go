@@ -318,7 +318,18 @@ func TestAccAzureRMContainerRegistry_geoReplication(t *testing.T) {
 					testCheckAzureRMContainerRegistryGeoreplications(dsn, skuPremium, []string{`"eastus"`, `"westus"`}),
 				),
 			},
-			// fifth config updates the SKU to basic and no replicas (should remove the existing replicas if any)
+			// fifth config migrates from `georeplication_locations` to `georeplications` (should keep the existing replicas)
+			{
+				Config: testAccAzureRMContainerRegistry_geoReplications(ri, testLocation(), "eastus", "westus"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsn, "sku", skuPremium),
+					resource.TestCheckResourceAttr(dsn, "georeplication_locations.#", "0"),
+					resource.TestCheckResourceAttr(dsn, "georeplications.#", "2"),
+					testCheckAzureRMContainerRegistryExists(dsn),
+					testCheckAzureRMContainerRegistryGeoreplications(dsn, skuPremium, []string{`"eastus"`, `"westus"`}),
+				),
+			},
+			// sixth config updates the SKU to basic and no replicas (should remove the existing replicas if any)
 			{
 				Config: testAccAzureRMContainerRegistry_geoReplicationUpdateWithNoLocation_basic(ri, testLocation()),
 				Check: resource.ComposeTestCheckFunc(
@@ -399,6 +410,40 @@ func TestAccAzureRMContainerRegistry_networkAccessProfile_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMContainerRegistry_policies(t *testing.T) {
+	rn := "azurerm_container_registry.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMContainerRegistry_policies(ri, testLocation(), true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryExists(rn),
+					resource.TestCheckResourceAttr(rn, "quarantine_policy_enabled", "true"),
+					resource.TestCheckResourceAttr(rn, "trust_policy.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      rn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMContainerRegistry_policies(ri, testLocation(), false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMContainerRegistryExists(rn),
+					resource.TestCheckResourceAttr(rn, "quarantine_policy_enabled", "false"),
+					resource.TestCheckResourceAttr(rn, "trust_policy.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMContainerRegistryDestroy(s *terraform.State) error {
 	conn := testAccProvider.Meta().(*ArmClient).containers.RegistriesClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -454,6 +499,49 @@ func testCheckAzureRMContainerRegistryExists(resourceName string) resource.TestC
 	}
 }
 
+func TestAccAzureRMContainerRegistry_geoReplications(t *testing.T) {
+	dsn := "azurerm_container_registry.test"
+	ri := tf.AccRandTimeInt()
+	skuPremium := "Premium"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMContainerRegistryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMContainerRegistry_geoReplications(ri, testLocation(), "eastus", "westus"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsn, "georeplications.#", "2"),
+					testCheckAzureRMContainerRegistryExists(dsn),
+					testCheckAzureRMContainerRegistryGeoreplications(dsn, skuPremium, []string{`"eastus"`, `"westus"`}),
+				),
+			},
+			{
+				ResourceName:      dsn,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMContainerRegistry_geoReplications(ri, testLocation(), "eastus", "centralus"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsn, "georeplications.#", "2"),
+					testCheckAzureRMContainerRegistryExists(dsn),
+					testCheckAzureRMContainerRegistryGeoreplications(dsn, skuPremium, []string{`"eastus"`, `"centralus"`}),
+				),
+			},
+			{
+				Config: testAccAzureRMContainerRegistry_geoReplicationsEmpty(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dsn, "georeplications.#", "0"),
+					testCheckAzureRMContainerRegistryExists(dsn),
+					testCheckAzureRMContainerRegistryGeoreplications(dsn, skuPremium, nil),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMContainerRegistryGeoreplications(resourceName string, sku string, expectedLocations []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -665,3 +753,69 @@ resource "azurerm_container_registry" "test" {
 }
 `, rInt, location, sku)
 }
+
+func testAccAzureRMContainerRegistry_policies(rInt int, location string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRg-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                      = "testacccr%d"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  location                  = "${azurerm_resource_group.test.location}"
+  sku                       = "Premium"
+  quarantine_policy_enabled = %t
+
+  trust_policy {
+    enabled = %t
+  }
+}
+`, rInt, location, rInt, enabled, enabled)
+}
+
+func testAccAzureRMContainerRegistry_geoReplications(rInt int, location string, primary string, secondary string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "testAccRg-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Premium"
+
+  georeplications {
+    location = "%s"
+  }
+
+  georeplications {
+    location = "%s"
+
+    tags = {
+      environment = "secondary"
+    }
+  }
+}
+`, rInt, location, rInt, primary, secondary)
+}
+
+func testAccAzureRMContainerRegistry_geoReplicationsEmpty(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "testAccRg-%d"
+  location = "%s"
+}
+
+resource "azurerm_container_registry" "test" {
+  name                = "testacccr%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "Premium"
+  georeplications     = []
+}
+`, rInt, location, rInt)
+}
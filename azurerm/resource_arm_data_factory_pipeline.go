@@ -11,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -68,13 +69,21 @@ func resourceArmDataFactoryPipeline() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+
+			"activities_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: azureRmDataFactoryPipelineActivitiesDiff,
+			},
 		},
 	}
 }
 
 func resourceArmDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataFactory.PipelinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Data Factory Pipeline creation.")
 
@@ -110,6 +119,14 @@ func resourceArmDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta int
 		pipeline.Annotations = &annotations
 	}
 
+	if v, ok := d.GetOk("activities_json"); ok {
+		activities, err := expandDataFactoryPipelineActivities(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing `activities_json`: %+v", err)
+		}
+		pipeline.Activities = activities
+	}
+
 	config := datafactory.PipelineResource{
 		Pipeline: pipeline,
 	}
@@ -134,7 +151,8 @@ func resourceArmDataFactoryPipelineCreateUpdate(d *schema.ResourceData, meta int
 
 func resourceArmDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataFactory.PipelinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -175,6 +193,11 @@ func resourceArmDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}
 			return fmt.Errorf("Error setting `variables`: %+v", err)
 		}
 
+		activities, err := flattenDataFactoryPipelineActivities(props.Activities)
+		if err != nil {
+			return fmt.Errorf("Error flattening `activities_json`: %+v", err)
+		}
+		d.Set("activities_json", activities)
 	}
 
 	return nil
@@ -182,7 +205,8 @@ func resourceArmDataFactoryPipelineRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmDataFactoryPipelineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).dataFactory.PipelinesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -0,0 +1,338 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDataFactoryTriggerSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDataFactoryTriggerScheduleCreateUpdate,
+		Read:   resourceArmDataFactoryTriggerScheduleRead,
+		Update: resourceArmDataFactoryTriggerScheduleCreateUpdate,
+		Delete: resourceArmDataFactoryTriggerScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAzureRMDataFactoryLinkedServiceDatasetName,
+			},
+
+			"data_factory_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^[A-Za-z0-9]+(?:-[A-Za-z0-9]+)*$`),
+					`Invalid data_factory_name, see https://docs.microsoft.com/en-us/azure/data-factory/naming-rules`,
+				),
+			},
+
+			// There's a bug in the Azure API where this is returned in lower-case
+			// BUG: https://github.com/Azure/azure-rest-api-specs/issues/5788
+			"resource_group_name": azure.SchemaResourceGroupNameDiffSuppress(),
+
+			"pipeline_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAzureRMDataFactoryPipelineName,
+			},
+
+			"pipeline_parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+
+			"frequency": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(datafactory.Minute),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(datafactory.Minute),
+					string(datafactory.Hour),
+					string(datafactory.Day),
+					string(datafactory.Week),
+					string(datafactory.Month),
+				}, false),
+			},
+
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"start_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validate.RFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"end_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validate.RFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"activated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"annotations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmDataFactoryTriggerScheduleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactory.TriggersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Data Factory Trigger Schedule creation.")
+
+	resourceGroupName := d.Get("resource_group_name").(string)
+	name := d.Get("name").(string)
+	dataFactoryName := d.Get("data_factory_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroupName, dataFactoryName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Data Factory Trigger Schedule %q (Resource Group %q / Data Factory %q): %s", name, resourceGroupName, dataFactoryName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_data_factory_trigger_schedule", *existing.ID)
+		}
+	}
+
+	// a Trigger can only be updated once it's been stopped
+	if !d.IsNewResource() {
+		if err := stopDataFactoryTrigger(ctx, client, resourceGroupName, dataFactoryName, name); err != nil {
+			return err
+		}
+	}
+
+	recurrence := &datafactory.ScheduleTriggerRecurrence{
+		Frequency: datafactory.RecurrenceFrequency(d.Get("frequency").(string)),
+		Interval:  utils.Int32(int32(d.Get("interval").(int))),
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string)) // should be validated by the schema
+		recurrence.StartTime = &date.Time{Time: t}
+	} else {
+		recurrence.StartTime = &date.Time{Time: time.Now()}
+	}
+
+	if v, ok := d.GetOk("end_time"); ok {
+		t, _ := time.Parse(time.RFC3339, v.(string)) // should be validated by the schema
+		recurrence.EndTime = &date.Time{Time: t}
+	}
+
+	pipelineType := "PipelineReference"
+	scheduleTrigger := &datafactory.ScheduleTrigger{
+		ScheduleTriggerTypeProperties: &datafactory.ScheduleTriggerTypeProperties{
+			Recurrence: recurrence,
+		},
+		Pipelines: &[]datafactory.TriggerPipelineReference{
+			{
+				PipelineReference: &datafactory.PipelineReference{
+					ReferenceName: utils.String(d.Get("pipeline_name").(string)),
+					Type:          &pipelineType,
+				},
+				Parameters: d.Get("pipeline_parameters").(map[string]interface{}),
+			},
+		},
+		Type: datafactory.TypeScheduleTrigger,
+	}
+
+	if v, ok := d.GetOk("annotations"); ok {
+		annotations := v.([]interface{})
+		scheduleTrigger.Annotations = &annotations
+	}
+
+	trigger := datafactory.TriggerResource{
+		Properties: scheduleTrigger,
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroupName, dataFactoryName, name, trigger, ""); err != nil {
+		return fmt.Errorf("Error creating/updating Data Factory Trigger Schedule %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroupName, dataFactoryName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory Trigger Schedule %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Data Factory Trigger Schedule %q (Resource Group %q / Data Factory %q) ID", name, resourceGroupName, dataFactoryName)
+	}
+
+	d.SetId(*read.ID)
+
+	if d.Get("activated").(bool) {
+		log.Printf("[DEBUG] Starting Data Factory Trigger Schedule %q (Resource Group %q / Data Factory %q)", name, resourceGroupName, dataFactoryName)
+		future, err := client.Start(ctx, resourceGroupName, dataFactoryName, name)
+		if err != nil {
+			return fmt.Errorf("Error starting Data Factory Trigger Schedule %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Data Factory Trigger Schedule %q (Resource Group %q / Data Factory %q) to start: %+v", name, resourceGroupName, dataFactoryName, err)
+		}
+	}
+
+	return resourceArmDataFactoryTriggerScheduleRead(d, meta)
+}
+
+func resourceArmDataFactoryTriggerScheduleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactory.TriggersClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["triggers"]
+
+	resp, err := client.Get(ctx, id.ResourceGroup, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			d.SetId("")
+			log.Printf("[DEBUG] Data Factory Trigger Schedule %q was not found in Resource Group %q - removing from state!", name, id.ResourceGroup)
+			return nil
+		}
+		return fmt.Errorf("Error reading the state of Data Factory Trigger Schedule %q: %+v", name, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("data_factory_name", dataFactoryName)
+
+	scheduleTrigger, ok := resp.Properties.AsScheduleTrigger()
+	if !ok {
+		return fmt.Errorf("Error classifiying Data Factory Trigger Schedule %q (Data Factory %q / Resource Group %q): Expected: %q Received: %q", name, dataFactoryName, id.ResourceGroup, datafactory.TypeScheduleTrigger, *resp.Type)
+	}
+
+	d.Set("activated", scheduleTrigger.RuntimeState == datafactory.Started)
+
+	if props := scheduleTrigger.ScheduleTriggerTypeProperties; props != nil {
+		if recurrence := props.Recurrence; recurrence != nil {
+			d.Set("frequency", string(recurrence.Frequency))
+			d.Set("interval", recurrence.Interval)
+
+			if v := recurrence.StartTime; v != nil {
+				d.Set("start_time", v.Format(time.RFC3339))
+			}
+			if v := recurrence.EndTime; v != nil {
+				d.Set("end_time", v.Format(time.RFC3339))
+			}
+		}
+	}
+
+	if pipelines := scheduleTrigger.Pipelines; pipelines != nil && len(*pipelines) > 0 {
+		pipeline := (*pipelines)[0]
+		if reference := pipeline.PipelineReference; reference != nil {
+			d.Set("pipeline_name", reference.ReferenceName)
+		}
+		d.Set("pipeline_parameters", pipeline.Parameters)
+	}
+
+	annotations := flattenDataFactoryAnnotations(scheduleTrigger.Annotations)
+	if err := d.Set("annotations", annotations); err != nil {
+		return fmt.Errorf("Error setting `annotations`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDataFactoryTriggerScheduleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dataFactory.TriggersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	dataFactoryName := id.Path["factories"]
+	name := id.Path["triggers"]
+	resourceGroupName := id.ResourceGroup
+
+	// a Trigger can only be deleted once it's been stopped
+	if err := stopDataFactoryTrigger(ctx, client, resourceGroupName, dataFactoryName, name); err != nil {
+		return err
+	}
+
+	if _, err = client.Delete(ctx, resourceGroupName, dataFactoryName, name); err != nil {
+		return fmt.Errorf("Error deleting Data Factory Trigger Schedule %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, err)
+	}
+
+	return nil
+}
+
+func stopDataFactoryTrigger(ctx context.Context, client *datafactory.TriggersClient, resourceGroupName, dataFactoryName, name string) error {
+	resp, err := client.Get(ctx, resourceGroupName, dataFactoryName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Data Factory Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, err)
+	}
+
+	if resp.Properties == nil {
+		return nil
+	}
+	if trigger, ok := resp.Properties.AsScheduleTrigger(); !ok || trigger.RuntimeState != datafactory.Started {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Stopping Data Factory Trigger %q (Resource Group %q / Data Factory %q)", name, resourceGroupName, dataFactoryName)
+	future, err := client.Stop(ctx, resourceGroupName, dataFactoryName, name)
+	if err != nil {
+		return fmt.Errorf("Error stopping Data Factory Trigger %q (Resource Group %q / Data Factory %q): %+v", name, resourceGroupName, dataFactoryName, err)
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Data Factory Trigger %q (Resource Group %q / Data Factory %q) to stop: %+v", name, resourceGroupName, dataFactoryName, err)
+	}
+
+	return nil
+}
@@ -10,10 +10,12 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -63,13 +65,72 @@ func resourceArmDatabricksWorkspace() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"custom_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"no_public_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+
+						"public_subnet_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"private_subnet_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validate.NoEmptyStrings,
+						},
+
+						"virtual_network_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+
+						// these aren't sent to the API, but ensure the Network Security Groups are associated
+						// with the Subnets before the Workspace is created (and removed after it's deleted)
+						"public_subnet_network_security_group_association_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+
+						"private_subnet_network_security_group_association_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceArmDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).databricks.WorkspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	subscriptionID := meta.(*ArmClient).subscriptionId
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Databricks Workspace creation.")
@@ -107,6 +168,11 @@ func resourceArmDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta int
 		managedResourceGroupID = fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionID, managedResourceGroupName)
 	}
 
+	customParameters, err := expandDatabricksWorkspaceCustomParameters(d.Get("custom_parameters").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("Error expanding `custom_parameters`: %+v", err)
+	}
+
 	workspace := databricks.Workspace{
 		Sku: &databricks.Sku{
 			Name: utils.String(skuName),
@@ -114,6 +180,7 @@ func resourceArmDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta int
 		Location: utils.String(location),
 		WorkspaceProperties: &databricks.WorkspaceProperties{
 			ManagedResourceGroupID: &managedResourceGroupID,
+			Parameters:             customParameters,
 		},
 		Tags: expandedTags,
 	}
@@ -142,7 +209,8 @@ func resourceArmDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta int
 
 func resourceArmDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).databricks.WorkspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -181,6 +249,10 @@ func resourceArmDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}
 		}
 		d.Set("managed_resource_group_id", props.ManagedResourceGroupID)
 		d.Set("managed_resource_group_name", managedResourceGroupID.ResourceGroup)
+
+		if err := d.Set("custom_parameters", flattenDatabricksWorkspaceCustomParameters(d, props.Parameters)); err != nil {
+			return fmt.Errorf("Error setting `custom_parameters`: %+v", err)
+		}
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -188,7 +260,8 @@ func resourceArmDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}
 
 func resourceArmDatabricksWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).databricks.WorkspacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -212,6 +285,95 @@ func resourceArmDatabricksWorkspaceDelete(d *schema.ResourceData, meta interface
 	return nil
 }
 
+func expandDatabricksWorkspaceCustomParameters(input []interface{}) (map[string]interface{}, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	config := input[0].(map[string]interface{})
+	publicSubnetName := config["public_subnet_name"].(string)
+	privateSubnetName := config["private_subnet_name"].(string)
+	virtualNetworkId := config["virtual_network_id"].(string)
+
+	parameters := make(map[string]interface{})
+	if config["no_public_ip"].(bool) {
+		parameters["enableNoPublicIp"] = databricksWorkspaceCustomParameter(true)
+	}
+
+	if publicSubnetName != "" || privateSubnetName != "" || virtualNetworkId != "" {
+		if publicSubnetName == "" || privateSubnetName == "" || virtualNetworkId == "" {
+			return nil, fmt.Errorf("`public_subnet_name`, `private_subnet_name` and `virtual_network_id` must all be specified when injecting the Workspace into a Virtual Network")
+		}
+
+		parameters["customPublicSubnetName"] = databricksWorkspaceCustomParameter(publicSubnetName)
+		parameters["customPrivateSubnetName"] = databricksWorkspaceCustomParameter(privateSubnetName)
+		parameters["customVirtualNetworkId"] = databricksWorkspaceCustomParameter(virtualNetworkId)
+	}
+
+	if len(parameters) == 0 {
+		return nil, nil
+	}
+
+	return parameters, nil
+}
+
+func databricksWorkspaceCustomParameter(value interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"value": value,
+	}
+}
+
+func flattenDatabricksWorkspaceCustomParameters(d *schema.ResourceData, input interface{}) []interface{} {
+	parameters, ok := input.(map[string]interface{})
+	if !ok {
+		return []interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"no_public_ip":        false,
+		"public_subnet_name":  "",
+		"private_subnet_name": "",
+		"virtual_network_id":  "",
+	}
+	keys := map[string]string{
+		"enableNoPublicIp":        "no_public_ip",
+		"customPublicSubnetName":  "public_subnet_name",
+		"customPrivateSubnetName": "private_subnet_name",
+		"customVirtualNetworkId":  "virtual_network_id",
+	}
+
+	found := false
+	for apiKey, key := range keys {
+		parameter, ok := parameters[apiKey].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch value := parameter["value"].(type) {
+		case bool:
+			if key == "no_public_ip" {
+				result[key] = value
+				found = true
+			}
+		case string:
+			if key != "no_public_ip" {
+				result[key] = value
+				found = true
+			}
+		}
+	}
+
+	if !found {
+		return []interface{}{}
+	}
+
+	// the Network Security Group Associations aren't returned from the API, so we pull them from the config
+	result["public_subnet_network_security_group_association_id"] = d.Get("custom_parameters.0.public_subnet_network_security_group_association_id").(string)
+	result["private_subnet_network_security_group_association_id"] = d.Get("custom_parameters.0.private_subnet_network_security_group_association_id").(string)
+
+	return []interface{}{result}
+}
+
 func validateDatabricksWorkspaceName(i interface{}, k string) (warnings []string, errors []error) {
 	v, ok := i.(string)
 	if !ok {
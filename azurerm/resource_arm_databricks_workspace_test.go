@@ -162,6 +162,40 @@ func TestAccAzureRMDatabricksWorkspace_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDatabricksWorkspace_virtualNetwork(t *testing.T) {
+	resourceName := "azurerm_databricks_workspace.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDatabricksWorkspaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDatabricksWorkspace_virtualNetwork(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDatabricksWorkspaceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "custom_parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_parameters.0.no_public_ip", "true"),
+					resource.TestCheckResourceAttr(resourceName, "custom_parameters.0.public_subnet_name", fmt.Sprintf("acctest-sn-public-%d", ri)),
+					resource.TestCheckResourceAttr(resourceName, "custom_parameters.0.private_subnet_name", fmt.Sprintf("acctest-sn-private-%d", ri)),
+					resource.TestCheckResourceAttrSet(resourceName, "custom_parameters.0.virtual_network_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the Network Security Group Associations aren't returned from the API
+				ImportStateVerifyIgnore: []string{
+					"custom_parameters.0.public_subnet_network_security_group_association_id",
+					"custom_parameters.0.private_subnet_network_security_group_association_id",
+				},
+			},
+		},
+	})
+}
+
 func testCheckAzureRMDatabricksWorkspaceExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -287,3 +321,84 @@ resource "azurerm_databricks_workspace" "test" {
 }
 `, rInt, location, rInt, rInt)
 }
+
+func testAccAzureRMDatabricksWorkspace_virtualNetwork(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctest-nsg-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "public" {
+  name                      = "acctest-sn-public-%[1]d"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  virtual_network_name      = "${azurerm_virtual_network.test.name}"
+  address_prefix            = "10.0.1.0/24"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+
+  delegation {
+    name = "acctest"
+
+    service_delegation {
+      name = "Microsoft.Databricks/workspaces"
+    }
+  }
+}
+
+resource "azurerm_subnet" "private" {
+  name                      = "acctest-sn-private-%[1]d"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  virtual_network_name      = "${azurerm_virtual_network.test.name}"
+  address_prefix            = "10.0.2.0/24"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+
+  delegation {
+    name = "acctest"
+
+    service_delegation {
+      name = "Microsoft.Databricks/workspaces"
+    }
+  }
+}
+
+resource "azurerm_subnet_network_security_group_association" "public" {
+  subnet_id                 = "${azurerm_subnet.public.id}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+}
+
+resource "azurerm_subnet_network_security_group_association" "private" {
+  subnet_id                 = "${azurerm_subnet.private.id}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+}
+
+resource "azurerm_databricks_workspace" "test" {
+  name                = "acctestdbw-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  sku                 = "premium"
+
+  custom_parameters {
+    no_public_ip        = true
+    public_subnet_name  = "${azurerm_subnet.public.name}"
+    private_subnet_name = "${azurerm_subnet.private.name}"
+    virtual_network_id  = "${azurerm_virtual_network.test.id}"
+
+    public_subnet_network_security_group_association_id  = "${azurerm_subnet_network_security_group_association.public.id}"
+    private_subnet_network_security_group_association_id = "${azurerm_subnet_network_security_group_association.private.id}"
+  }
+}
+`, rInt, location)
+}
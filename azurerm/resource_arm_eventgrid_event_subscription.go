@@ -10,9 +10,11 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -67,9 +69,10 @@ func resourceArmEventGridEventSubscription() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"storage_account_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 						"queue_name": {
 							Type:         schema.TypeString,
@@ -88,9 +91,10 @@ func resourceArmEventGridEventSubscription() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"eventhub_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 					},
 				},
@@ -104,9 +108,10 @@ func resourceArmEventGridEventSubscription() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"hybrid_connection_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 					},
 				},
@@ -159,6 +164,28 @@ func resourceArmEventGridEventSubscription() *schema.Resource {
 				},
 			},
 
+			"advanced_filter": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bool_equals":                   eventSubscriptionSchemaAdvancedFilter(schema.TypeBool, false),
+						"number_greater_than":           eventSubscriptionSchemaAdvancedFilter(schema.TypeFloat, false),
+						"number_greater_than_or_equals": eventSubscriptionSchemaAdvancedFilter(schema.TypeFloat, false),
+						"number_less_than":              eventSubscriptionSchemaAdvancedFilter(schema.TypeFloat, false),
+						"number_less_than_or_equals":    eventSubscriptionSchemaAdvancedFilter(schema.TypeFloat, false),
+						"number_in":                     eventSubscriptionSchemaAdvancedFilter(schema.TypeFloat, true),
+						"number_not_in":                 eventSubscriptionSchemaAdvancedFilter(schema.TypeFloat, true),
+						"string_begins_with":            eventSubscriptionSchemaAdvancedFilter(schema.TypeString, true),
+						"string_ends_with":              eventSubscriptionSchemaAdvancedFilter(schema.TypeString, true),
+						"string_contains":               eventSubscriptionSchemaAdvancedFilter(schema.TypeString, true),
+						"string_in":                     eventSubscriptionSchemaAdvancedFilter(schema.TypeString, true),
+						"string_not_in":                 eventSubscriptionSchemaAdvancedFilter(schema.TypeString, true),
+					},
+				},
+			},
+
 			"storage_blob_dead_letter_destination": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -166,9 +193,10 @@ func resourceArmEventGridEventSubscription() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"storage_account_id": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 						"storage_blob_container_name": {
 							Type:         schema.TypeString,
@@ -211,9 +239,44 @@ func resourceArmEventGridEventSubscription() *schema.Resource {
 	}
 }
 
+func eventSubscriptionSchemaAdvancedFilter(valueType schema.ValueType, multipleValues bool) *schema.Schema {
+	filterSchema := map[string]*schema.Schema{
+		"key": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validate.NoEmptyStrings,
+		},
+	}
+
+	if multipleValues {
+		filterSchema["values"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 5,
+			Elem: &schema.Schema{
+				Type: valueType,
+			},
+		}
+	} else {
+		filterSchema["value"] = &schema.Schema{
+			Type:     valueType,
+			Required: true,
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: filterSchema,
+		},
+	}
+}
+
 func resourceArmEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.EventSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	scope := d.Get("scope").(string)
@@ -279,7 +342,8 @@ func resourceArmEventGridEventSubscriptionCreateUpdate(d *schema.ResourceData, m
 
 func resourceArmEventGridEventSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.EventSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureEventGridEventSubscriptionID(d.Id())
 	if err != nil {
@@ -339,6 +403,9 @@ func resourceArmEventGridEventSubscriptionRead(d *schema.ResourceData, meta inte
 			if err := d.Set("subject_filter", flattenEventGridEventSubscriptionSubjectFilter(filter)); err != nil {
 				return fmt.Errorf("Error setting `subject_filter` for EventGrid Event Subscription %q (Scope %q): %s", name, scope, err)
 			}
+			if err := d.Set("advanced_filter", flattenEventGridEventSubscriptionAdvancedFilter(filter.AdvancedFilters)); err != nil {
+				return fmt.Errorf("Error setting `advanced_filter` for EventGrid Event Subscription %q (Scope %q): %s", name, scope, err)
+			}
 		}
 
 		if props.DeadLetterDestination != nil {
@@ -365,7 +432,8 @@ func resourceArmEventGridEventSubscriptionRead(d *schema.ResourceData, meta inte
 
 func resourceArmEventGridEventSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).eventGrid.EventSubscriptionsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureEventGridEventSubscriptionID(d.Id())
 	if err != nil {
@@ -504,9 +572,113 @@ func expandEventGridEventSubscriptionFilter(d *schema.ResourceData) *eventgrid.E
 		filter.IsSubjectCaseSensitive = &caseSensitive
 	}
 
+	if advancedFilter, ok := d.GetOk("advanced_filter"); ok {
+		filter.AdvancedFilters = expandEventGridEventSubscriptionAdvancedFilter(advancedFilter.([]interface{}))
+	}
+
 	return filter
 }
 
+func expandEventGridEventSubscriptionAdvancedFilter(input []interface{}) *[]eventgrid.BasicAdvancedFilter {
+	advancedFilters := make([]eventgrid.BasicAdvancedFilter, 0)
+	if len(input) == 0 || input[0] == nil {
+		return &advancedFilters
+	}
+	config := input[0].(map[string]interface{})
+
+	for operatorType, filters := range config {
+		for _, v := range filters.([]interface{}) {
+			filter := v.(map[string]interface{})
+			key := utils.String(filter["key"].(string))
+
+			switch operatorType {
+			case "bool_equals":
+				advancedFilters = append(advancedFilters, eventgrid.BoolEqualsAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeBoolEquals,
+					Value:        utils.Bool(filter["value"].(bool)),
+				})
+			case "number_greater_than":
+				advancedFilters = append(advancedFilters, eventgrid.NumberGreaterThanAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeNumberGreaterThan,
+					Value:        utils.Float(filter["value"].(float64)),
+				})
+			case "number_greater_than_or_equals":
+				advancedFilters = append(advancedFilters, eventgrid.NumberGreaterThanOrEqualsAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeNumberGreaterThanOrEquals,
+					Value:        utils.Float(filter["value"].(float64)),
+				})
+			case "number_less_than":
+				advancedFilters = append(advancedFilters, eventgrid.NumberLessThanAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeNumberLessThan,
+					Value:        utils.Float(filter["value"].(float64)),
+				})
+			case "number_less_than_or_equals":
+				advancedFilters = append(advancedFilters, eventgrid.NumberLessThanOrEqualsAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeNumberLessThanOrEquals,
+					Value:        utils.Float(filter["value"].(float64)),
+				})
+			case "number_in":
+				advancedFilters = append(advancedFilters, eventgrid.NumberInAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeNumberIn,
+					Values:       expandEventGridEventSubscriptionAdvancedFilterFloatValues(filter["values"].([]interface{})),
+				})
+			case "number_not_in":
+				advancedFilters = append(advancedFilters, eventgrid.NumberNotInAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeNumberNotIn,
+					Values:       expandEventGridEventSubscriptionAdvancedFilterFloatValues(filter["values"].([]interface{})),
+				})
+			case "string_begins_with":
+				advancedFilters = append(advancedFilters, eventgrid.StringBeginsWithAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeStringBeginsWith,
+					Values:       utils.ExpandStringSlice(filter["values"].([]interface{})),
+				})
+			case "string_ends_with":
+				advancedFilters = append(advancedFilters, eventgrid.StringEndsWithAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeStringEndsWith,
+					Values:       utils.ExpandStringSlice(filter["values"].([]interface{})),
+				})
+			case "string_contains":
+				advancedFilters = append(advancedFilters, eventgrid.StringContainsAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeStringContains,
+					Values:       utils.ExpandStringSlice(filter["values"].([]interface{})),
+				})
+			case "string_in":
+				advancedFilters = append(advancedFilters, eventgrid.StringInAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeStringIn,
+					Values:       utils.ExpandStringSlice(filter["values"].([]interface{})),
+				})
+			case "string_not_in":
+				advancedFilters = append(advancedFilters, eventgrid.StringNotInAdvancedFilter{
+					Key:          key,
+					OperatorType: eventgrid.OperatorTypeStringNotIn,
+					Values:       utils.ExpandStringSlice(filter["values"].([]interface{})),
+				})
+			}
+		}
+	}
+
+	return &advancedFilters
+}
+
+func expandEventGridEventSubscriptionAdvancedFilterFloatValues(input []interface{}) *[]float64 {
+	values := make([]float64, 0)
+	for _, v := range input {
+		values = append(values, v.(float64))
+	}
+	return &values
+}
+
 func expandEventGridEventSubscriptionStorageBlobDeadLetterDestination(d *schema.ResourceData) eventgrid.BasicDeadLetterDestination {
 	if v, ok := d.GetOk("storage_blob_dead_letter_destination"); ok {
 		dest := v.([]interface{})[0].(map[string]interface{})
@@ -612,6 +784,91 @@ func flattenEventGridEventSubscriptionSubjectFilter(filter *eventgrid.EventSubsc
 	return []interface{}{result}
 }
 
+func flattenEventGridEventSubscriptionAdvancedFilter(input *[]eventgrid.BasicAdvancedFilter) []interface{} {
+	if input == nil || len(*input) == 0 {
+		return []interface{}{}
+	}
+
+	result := map[string][]interface{}{}
+	for _, item := range *input {
+		switch f := item.(type) {
+		case eventgrid.BoolEqualsAdvancedFilter:
+			result["bool_equals"] = append(result["bool_equals"], flattenEventGridEventSubscriptionAdvancedFilterValue(f.Key, f.Value))
+		case eventgrid.NumberGreaterThanAdvancedFilter:
+			result["number_greater_than"] = append(result["number_greater_than"], flattenEventGridEventSubscriptionAdvancedFilterValue(f.Key, f.Value))
+		case eventgrid.NumberGreaterThanOrEqualsAdvancedFilter:
+			result["number_greater_than_or_equals"] = append(result["number_greater_than_or_equals"], flattenEventGridEventSubscriptionAdvancedFilterValue(f.Key, f.Value))
+		case eventgrid.NumberLessThanAdvancedFilter:
+			result["number_less_than"] = append(result["number_less_than"], flattenEventGridEventSubscriptionAdvancedFilterValue(f.Key, f.Value))
+		case eventgrid.NumberLessThanOrEqualsAdvancedFilter:
+			result["number_less_than_or_equals"] = append(result["number_less_than_or_equals"], flattenEventGridEventSubscriptionAdvancedFilterValue(f.Key, f.Value))
+		case eventgrid.NumberInAdvancedFilter:
+			result["number_in"] = append(result["number_in"], flattenEventGridEventSubscriptionAdvancedFilterValues(f.Key, f.Values))
+		case eventgrid.NumberNotInAdvancedFilter:
+			result["number_not_in"] = append(result["number_not_in"], flattenEventGridEventSubscriptionAdvancedFilterValues(f.Key, f.Values))
+		case eventgrid.StringBeginsWithAdvancedFilter:
+			result["string_begins_with"] = append(result["string_begins_with"], flattenEventGridEventSubscriptionAdvancedFilterValues(f.Key, utils.FlattenStringSlice(f.Values)))
+		case eventgrid.StringEndsWithAdvancedFilter:
+			result["string_ends_with"] = append(result["string_ends_with"], flattenEventGridEventSubscriptionAdvancedFilterValues(f.Key, utils.FlattenStringSlice(f.Values)))
+		case eventgrid.StringContainsAdvancedFilter:
+			result["string_contains"] = append(result["string_contains"], flattenEventGridEventSubscriptionAdvancedFilterValues(f.Key, utils.FlattenStringSlice(f.Values)))
+		case eventgrid.StringInAdvancedFilter:
+			result["string_in"] = append(result["string_in"], flattenEventGridEventSubscriptionAdvancedFilterValues(f.Key, utils.FlattenStringSlice(f.Values)))
+		case eventgrid.StringNotInAdvancedFilter:
+			result["string_not_in"] = append(result["string_not_in"], flattenEventGridEventSubscriptionAdvancedFilterValues(f.Key, utils.FlattenStringSlice(f.Values)))
+		}
+	}
+
+	output := make(map[string]interface{})
+	for k, v := range result {
+		output[k] = v
+	}
+
+	return []interface{}{output}
+}
+
+func flattenEventGridEventSubscriptionAdvancedFilterValue(key *string, value interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if key != nil {
+		result["key"] = *key
+	}
+
+	switch v := value.(type) {
+	case *bool:
+		if v != nil {
+			result["value"] = *v
+		}
+	case *float64:
+		if v != nil {
+			result["value"] = *v
+		}
+	}
+
+	return result
+}
+
+func flattenEventGridEventSubscriptionAdvancedFilterValues(key *string, values interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if key != nil {
+		result["key"] = *key
+	}
+
+	switch v := values.(type) {
+	case *[]float64:
+		output := make([]interface{}, 0)
+		if v != nil {
+			for _, item := range *v {
+				output = append(output, item)
+			}
+		}
+		result["values"] = output
+	case []interface{}:
+		result["values"] = v
+	}
+
+	return result
+}
+
 func flattenEventGridEventSubscriptionStorageBlobDeadLetterDestination(dest *eventgrid.StorageBlobDeadLetterDestination) []interface{} {
 	if dest == nil {
 		return nil
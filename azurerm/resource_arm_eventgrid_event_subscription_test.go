@@ -150,6 +150,41 @@ func TestAccAzureRMEventGridEventSubscription_filter(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMEventGridEventSubscription_advancedFilter(t *testing.T) {
+	resourceName := "azurerm_eventgrid_event_subscription.test"
+	ri := tf.AccRandTimeInt()
+	rs := strings.ToLower(acctest.RandString(11))
+
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventGridEventSubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMEventGridEventSubscription_advancedFilter(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventGridEventSubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "advanced_filter.0.bool_equals.0.key", "subject"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_filter.0.bool_equals.0.value", "true"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_filter.0.number_greater_than.0.key", "data.metadataVersion"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_filter.0.number_greater_than.0.value", "1"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_filter.0.number_in.0.values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_filter.0.string_begins_with.0.key", "subject"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_filter.0.string_begins_with.0.values.0", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "advanced_filter.0.string_not_in.0.values.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMEventGridEventSubscriptionDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).eventGrid.EventSubscriptionsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -425,3 +460,67 @@ resource "azurerm_eventgrid_event_subscription" "test" {
 }
 `, rInt, location, rString, rInt, rInt)
 }
+
+func testAccAzureRMEventGridEventSubscription_advancedFilter(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  tags = {
+    environment = "staging"
+  }
+}
+
+resource "azurerm_storage_queue" "test" {
+  name                 = "mysamplequeue-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_name = "${azurerm_storage_account.test.name}"
+}
+
+resource "azurerm_eventgrid_event_subscription" "test" {
+  name  = "acctesteg-%d"
+  scope = "${azurerm_resource_group.test.id}"
+
+  storage_queue_endpoint {
+    storage_account_id = "${azurerm_storage_account.test.id}"
+    queue_name         = "${azurerm_storage_queue.test.name}"
+  }
+
+  advanced_filter {
+    bool_equals {
+      key   = "subject"
+      value = true
+    }
+
+    number_greater_than {
+      key   = "data.metadataVersion"
+      value = 1
+    }
+
+    number_in {
+      key    = "data.contentLength"
+      values = [0, 1024]
+    }
+
+    string_begins_with {
+      key    = "subject"
+      values = ["foo"]
+    }
+
+    string_not_in {
+      key    = "data.api"
+      values = ["DeleteBlob", "PutBlockList"]
+    }
+  }
+}
+`, rInt, location, rString, rInt, rInt)
+}
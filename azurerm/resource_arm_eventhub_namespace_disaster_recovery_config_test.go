@@ -0,0 +1,243 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMEventHubNamespaceDisasterRecoveryConfig_basic(t *testing.T) {
+	resourceName := "azurerm_eventhub_namespace_disaster_recovery_config.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_basic(ri, testLocation(), testAltLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMEventHubNamespaceDisasterRecoveryConfig_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_eventhub_namespace_disaster_recovery_config.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_requiresImport(ri, location, altLocation),
+				ExpectError: testRequiresImportError("azurerm_eventhub_namespace_disaster_recovery_config"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMEventHubNamespaceDisasterRecoveryConfig_updatePartner(t *testing.T) {
+	resourceName := "azurerm_eventhub_namespace_disaster_recovery_config.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_updated(ri, location, altLocation, "secondary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_updated(ri, location, altLocation, "tertiary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).eventhub.DisasterRecoveryConfigsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_eventhub_namespace_disaster_recovery_config" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		namespaceName := id.Path["namespaces"]
+		name := id.Path["disasterRecoveryConfigs"]
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMEventHubNamespaceDisasterRecoveryConfigExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).eventhub.DisasterRecoveryConfigsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		namespaceName := id.Path["namespaces"]
+		name := id.Path["disasterRecoveryConfigs"]
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: EventHub Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q) does not exist", name, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on eventhub.DisasterRecoveryConfigsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_basic(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "primary" {
+  name                = "acctest-EHN-%d-primary"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub_namespace" "secondary" {
+  name                = "acctest-EHN-%d-secondary"
+  location            = "%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub_namespace_disaster_recovery_config" "test" {
+  name                 = "acctest-EHN-DRC-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  namespace_name       = "${azurerm_eventhub_namespace.primary.name}"
+  partner_namespace_id = "${azurerm_eventhub_namespace.secondary.id}"
+}
+`, rInt, location, rInt, rInt, altLocation, rInt)
+}
+
+func testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_requiresImport(rInt int, location string, altLocation string) string {
+	template := testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_basic(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_eventhub_namespace_disaster_recovery_config" "import" {
+  name                 = "${azurerm_eventhub_namespace_disaster_recovery_config.test.name}"
+  resource_group_name  = "${azurerm_eventhub_namespace_disaster_recovery_config.test.resource_group_name}"
+  namespace_name       = "${azurerm_eventhub_namespace_disaster_recovery_config.test.namespace_name}"
+  partner_namespace_id = "${azurerm_eventhub_namespace_disaster_recovery_config.test.partner_namespace_id}"
+}
+`, template)
+}
+
+func testAccAzureRMEventHubNamespaceDisasterRecoveryConfig_updated(rInt int, location string, altLocation string, partner string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_eventhub_namespace" "primary" {
+  name                = "acctest-EHN-%d-primary"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub_namespace" "secondary" {
+  name                = "acctest-EHN-%d-secondary"
+  location            = "%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub_namespace" "tertiary" {
+  name                = "acctest-EHN-%d-tertiary"
+  location            = "%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+}
+
+resource "azurerm_eventhub_namespace_disaster_recovery_config" "test" {
+  name                 = "acctest-EHN-DRC-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  namespace_name       = "${azurerm_eventhub_namespace.primary.name}"
+  partner_namespace_id = "${azurerm_eventhub_namespace.%s.id}"
+}
+`, rInt, location, rInt, rInt, altLocation, rInt, altLocation, rInt, partner)
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/hdinsight/mgmt/2018-06-01-preview/hdinsight"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,6 +11,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,6 +30,7 @@ var hdInsightInteractiveQueryClusterWorkerNodeDefinition = azure.HDInsightNodeDe
 	MinInstanceCount:        1,
 	MaxInstanceCount:        9,
 	CanSpecifyDisks:         false,
+	CanAutoScaleOnSchedule:  true,
 }
 
 var hdInsightInteractiveQueryClusterZookeeperNodeDefinition = azure.HDInsightNodeDefinition{
@@ -48,6 +51,13 @@ func resourceArmHDInsightInteractiveQueryCluster() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": azure.SchemaHDInsightName(),
 
@@ -76,8 +86,12 @@ func resourceArmHDInsightInteractiveQueryCluster() *schema.Resource {
 
 			"gateway": azure.SchemaHDInsightsGateway(),
 
+			"metastores": azure.SchemaHDInsightsExternalMetastores(),
+
 			"storage_account": azure.SchemaHDInsightsStorageAccounts(),
 
+			"monitor": azure.SchemaHDInsightsMonitor(),
+
 			"roles": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -110,7 +124,8 @@ func resourceArmHDInsightInteractiveQueryCluster() *schema.Resource {
 
 func resourceArmHDInsightInteractiveQueryClusterCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).hdinsight.ClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -123,7 +138,10 @@ func resourceArmHDInsightInteractiveQueryClusterCreate(d *schema.ResourceData, m
 	componentVersions := expandHDInsightInteractiveQueryComponentVersion(componentVersionsRaw)
 
 	gatewayRaw := d.Get("gateway").([]interface{})
-	gateway := azure.ExpandHDInsightsConfigurations(gatewayRaw)
+	configurations := azure.ExpandHDInsightsConfigurations(gatewayRaw)
+
+	metastoresRaw := d.Get("metastores").([]interface{})
+	azure.ExpandHDInsightsExternalMetastores(metastoresRaw, configurations)
 
 	storageAccountsRaw := d.Get("storage_account").([]interface{})
 	storageAccounts, err := azure.ExpandHDInsightsStorageAccounts(storageAccountsRaw)
@@ -164,7 +182,7 @@ func resourceArmHDInsightInteractiveQueryClusterCreate(d *schema.ResourceData, m
 			ClusterDefinition: &hdinsight.ClusterDefinition{
 				Kind:             utils.String("INTERACTIVEHIVE"),
 				ComponentVersion: componentVersions,
-				Configurations:   gateway,
+				Configurations:   configurations,
 			},
 			StorageProfile: &hdinsight.StorageProfile{
 				Storageaccounts: storageAccounts,
@@ -195,13 +213,20 @@ func resourceArmHDInsightInteractiveQueryClusterCreate(d *schema.ResourceData, m
 
 	d.SetId(*read.ID)
 
+	if v, ok := d.GetOk("monitor"); ok {
+		if err := hdinsightClusterUpdateMonitoring(ctx, meta, resourceGroup, name, v.([]interface{})); err != nil {
+			return fmt.Errorf("Error enabling Monitoring for HDInsight Interactive Query Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
 	return resourceArmHDInsightInteractiveQueryClusterRead(d, meta)
 }
 
 func resourceArmHDInsightInteractiveQueryClusterRead(d *schema.ResourceData, meta interface{}) error {
 	clustersClient := meta.(*ArmClient).hdinsight.ClustersClient
 	configurationsClient := meta.(*ArmClient).hdinsight.ConfigurationsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -227,6 +252,11 @@ func resourceArmHDInsightInteractiveQueryClusterRead(d *schema.ResourceData, met
 		return fmt.Errorf("Error retrieving Configuration for HDInsight Interactive Query Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	configurations, err := configurationsClient.List(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Configurations for HDInsight Interactive Query Cluster %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
 	d.Set("name", name)
 	d.Set("resource_group_name", resourceGroup)
 	if location := resp.Location; location != nil {
@@ -246,6 +276,11 @@ func resourceArmHDInsightInteractiveQueryClusterRead(d *schema.ResourceData, met
 			if err := d.Set("gateway", azure.FlattenHDInsightsConfigurations(configuration.Value)); err != nil {
 				return fmt.Errorf("Error flattening `gateway`: %+v", err)
 			}
+
+			metastores := azure.FlattenHDInsightsExternalMetastores(configurations.Configurations, d.Get("metastores").([]interface{}))
+			if err := d.Set("metastores", metastores); err != nil {
+				return fmt.Errorf("Error flattening `metastores`: %+v", err)
+			}
 		}
 
 		interactiveQueryRoles := hdInsightRoleDefinition{
@@ -264,6 +299,10 @@ func resourceArmHDInsightInteractiveQueryClusterRead(d *schema.ResourceData, met
 		d.Set("ssh_endpoint", sshEndpoint)
 	}
 
+	if err := hdinsightClusterReadMonitoring(ctx, d, meta, resourceGroup, name); err != nil {
+		return err
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -19,10 +19,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var iotDPSResourceName = "azurerm_iot_dps"
+
 func resourceArmIotDPS() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmIotDPSCreateUpdate,
@@ -106,11 +110,12 @@ func resourceArmIotDPS() *schema.Resource {
 							Sensitive: true,
 						},
 						"location": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: validate.NoEmptyStrings,
-							StateFunc:    azure.NormalizeLocation,
-							ForceNew:     true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     validate.NoEmptyStrings,
+							StateFunc:        azure.NormalizeLocation,
+							DiffSuppressFunc: azure.SuppressLocationDiff,
+							ForceNew:         true,
 						},
 						"apply_allocation_policy": {
 							Type:     schema.TypeBool,
@@ -131,6 +136,33 @@ func resourceArmIotDPS() *schema.Resource {
 				},
 			},
 
+			"allocation_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(iothub.Hashed),
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(iothub.Hashed),
+					string(iothub.GeoLatency),
+					string(iothub.Static),
+				}, true),
+			},
+
+			"device_provisioning_host_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"id_scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"service_operations_host_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -138,11 +170,15 @@ func resourceArmIotDPS() *schema.Resource {
 
 func resourceArmIotDPSCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.DPSResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
+	locks.ByName(name, iotDPSResourceName)
+	defer locks.UnlockByName(name, iotDPSResourceName)
+
 	if features.ShouldResourcesBeImported() && d.IsNewResource() {
 		existing, err := client.Get(ctx, name, resourceGroup)
 		if err != nil {
@@ -161,7 +197,8 @@ func resourceArmIotDPSCreateUpdate(d *schema.ResourceData, meta interface{}) err
 		Name:     utils.String(name),
 		Sku:      expandIoTDPSSku(d),
 		Properties: &iothub.IotDpsPropertiesDescription{
-			IotHubs: expandIoTDPSIoTHubs(d.Get("linked_hub").([]interface{})),
+			IotHubs:          expandIoTDPSIoTHubs(d.Get("linked_hub").([]interface{})),
+			AllocationPolicy: iothub.AllocationPolicy(d.Get("allocation_policy").(string)),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -191,7 +228,8 @@ func resourceArmIotDPSCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmIotDPSRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.DPSResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -224,6 +262,11 @@ func resourceArmIotDPSRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("linked_hub", flattenIoTDPSLinkedHub(props.IotHubs)); err != nil {
 			return fmt.Errorf("Error setting `linked_hub`: %+v", err)
 		}
+
+		d.Set("allocation_policy", string(props.AllocationPolicy))
+		d.Set("device_provisioning_host_name", props.DeviceProvisioningHostName)
+		d.Set("id_scope", props.IDScope)
+		d.Set("service_operations_host_name", props.ServiceOperationsHostName)
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -231,7 +274,8 @@ func resourceArmIotDPSRead(d *schema.ResourceData, meta interface{}) error {
 
 func resourceArmIotDPSDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).iothub.DPSResourceClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
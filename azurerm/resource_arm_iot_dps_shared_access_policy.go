@@ -0,0 +1,363 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/provisioningservices/mgmt/2018-01-22/iothub"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmIotDPSSharedAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmIotDPSSharedAccessPolicyCreateUpdate,
+		Read:   resourceArmIotDPSSharedAccessPolicyRead,
+		Update: resourceArmIotDPSSharedAccessPolicyCreateUpdate,
+		Delete: resourceArmIotDPSSharedAccessPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[a-zA-Z0-9!._-]{1,64}`), ""+
+					"The shared access policy key name must not be empty, and must not exceed 64 characters in length.  The shared access policy key name can only contain alphanumeric characters, exclamation marks, periods, underscores and hyphens."),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"iot_dps_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.IoTHubName,
+			},
+
+			"enrollment_read": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"enrollment_write": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"registration_read": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"registration_write": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"service_config": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"primary_key": {
+				Type:      schema.TypeString,
+				Sensitive: true,
+				Computed:  true,
+			},
+
+			"primary_connection_string": {
+				Type:      schema.TypeString,
+				Sensitive: true,
+				Computed:  true,
+			},
+
+			"secondary_key": {
+				Type:      schema.TypeString,
+				Sensitive: true,
+				Computed:  true,
+			},
+
+			"secondary_connection_string": {
+				Type:      schema.TypeString,
+				Sensitive: true,
+				Computed:  true,
+			},
+		},
+		CustomizeDiff: iotDPSSharedAccessPolicyCustomizeDiff,
+	}
+}
+
+func iotDPSSharedAccessPolicyCustomizeDiff(d *schema.ResourceDiff, _ interface{}) (err error) {
+	enrollmentRead := d.Get("enrollment_read").(bool)
+	enrollmentWrite := d.Get("enrollment_write").(bool)
+	registrationRead := d.Get("registration_read").(bool)
+	registrationWrite := d.Get("registration_write").(bool)
+	serviceConfig := d.Get("service_config").(bool)
+
+	if !enrollmentRead && !enrollmentWrite && !registrationRead && !registrationWrite && !serviceConfig {
+		err = multierror.Append(err, fmt.Errorf("At least one of `enrollment_read`, `enrollment_write`, `registration_read`, `registration_write` or `service_config` properties must be set to true"))
+	}
+
+	if enrollmentRead && !registrationRead {
+		err = multierror.Append(err, fmt.Errorf("If `enrollment_read` is set to true, `registration_read` must also be set to true"))
+	}
+
+	if registrationWrite && !registrationRead {
+		err = multierror.Append(err, fmt.Errorf("If `registration_write` is set to true, `registration_read` must also be set to true"))
+	}
+
+	if enrollmentWrite && (!enrollmentRead || !registrationRead || !registrationWrite) {
+		err = multierror.Append(err, fmt.Errorf("If `enrollment_write` is set to true, `enrollment_read`, `registration_read` and `registration_write` must also be set to true"))
+	}
+
+	return
+}
+
+func resourceArmIotDPSSharedAccessPolicyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iothub.DPSResourceClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	iotDPSName := d.Get("iot_dps_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	locks.ByName(iotDPSName, iotDPSResourceName)
+	defer locks.UnlockByName(iotDPSName, iotDPSResourceName)
+
+	iotDPS, err := client.Get(ctx, iotDPSName, resourceGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(iotDPS.Response) {
+			return fmt.Errorf("IoT Device Provisioning Service %q (Resource Group %q) was not found", iotDPSName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error loading IoT Device Provisioning Service %q (Resource Group %q): %+v", iotDPSName, resourceGroup, err)
+	}
+
+	keyName := d.Get("name").(string)
+
+	resourceId := fmt.Sprintf("%s/keys/%s", *iotDPS.ID, keyName)
+
+	expandedAccessPolicy := iothub.SharedAccessSignatureAuthorizationRuleAccessRightsDescription{
+		KeyName: &keyName,
+		Rights:  iothub.AccessRightsDescription(expandIoTDPSAccessRights(d)),
+	}
+
+	accessPolicies := make([]iothub.SharedAccessSignatureAuthorizationRuleAccessRightsDescription, 0)
+
+	alreadyExists := false
+	for accessPolicyIterator, err := client.ListKeysComplete(ctx, iotDPSName, resourceGroup); accessPolicyIterator.NotDone(); err = accessPolicyIterator.NextWithContext(ctx) {
+		if err != nil {
+			return fmt.Errorf("Error loading Shared Access Policies of IoT Device Provisioning Service %q (Resource Group %q): %+v", iotDPSName, resourceGroup, err)
+		}
+		existingAccessPolicy := accessPolicyIterator.Value()
+
+		if strings.EqualFold(*existingAccessPolicy.KeyName, keyName) {
+			if features.ShouldResourcesBeImported() && d.IsNewResource() {
+				return tf.ImportAsExistsError("azurerm_iot_dps_shared_access_policy", resourceId)
+			}
+			accessPolicies = append(accessPolicies, expandedAccessPolicy)
+			alreadyExists = true
+		} else {
+			accessPolicies = append(accessPolicies, existingAccessPolicy)
+		}
+	}
+
+	if d.IsNewResource() {
+		accessPolicies = append(accessPolicies, expandedAccessPolicy)
+	} else if !alreadyExists {
+		return fmt.Errorf("Unable to find Shared Access Policy %q defined for IoT Device Provisioning Service %q (Resource Group %q)", keyName, iotDPSName, resourceGroup)
+	}
+
+	iotDPS.Properties.AuthorizationPolicies = &accessPolicies
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, iotDPSName, iotDPS)
+	if err != nil {
+		return fmt.Errorf("Error updating IoT Device Provisioning Service %q (Resource Group %q) with Shared Access Policy %q: %+v", iotDPSName, resourceGroup, keyName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for IoT Device Provisioning Service %q (Resource Group %q) to finish updating Shared Access Policy %q: %+v", iotDPSName, resourceGroup, keyName, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmIotDPSSharedAccessPolicyRead(d, meta)
+}
+
+func resourceArmIotDPSSharedAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iothub.DPSResourceClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	iotDPSName := id.Path["provisioningServices"]
+	keyName := id.Path["keys"]
+
+	accessPolicy, err := client.ListKeysForKeyName(ctx, iotDPSName, keyName, resourceGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(accessPolicy.Response) {
+			log.Printf("[DEBUG] Shared Access Policy %q was not found on IoT Device Provisioning Service %q (Resource Group %q) - removing from state", keyName, iotDPSName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error loading Shared Access Policy %q of IoT Device Provisioning Service %q (Resource Group %q): %+v", keyName, iotDPSName, resourceGroup, err)
+	}
+
+	iotDPS, err := client.Get(ctx, iotDPSName, resourceGroup)
+	if err != nil {
+		return fmt.Errorf("Error loading IoT Device Provisioning Service %q (Resource Group %q): %+v", iotDPSName, resourceGroup, err)
+	}
+
+	d.Set("name", keyName)
+	d.Set("iot_dps_name", iotDPSName)
+	d.Set("resource_group_name", resourceGroup)
+
+	d.Set("primary_key", accessPolicy.PrimaryKey)
+	d.Set("secondary_key", accessPolicy.SecondaryKey)
+
+	if props := iotDPS.Properties; props != nil && props.ServiceOperationsHostName != nil {
+		hostName := *props.ServiceOperationsHostName
+		if accessPolicy.PrimaryKey != nil {
+			d.Set("primary_connection_string", getSharedAccessPolicyConnectionString(hostName, keyName, *accessPolicy.PrimaryKey))
+		}
+		if accessPolicy.SecondaryKey != nil {
+			d.Set("secondary_connection_string", getSharedAccessPolicyConnectionString(hostName, keyName, *accessPolicy.SecondaryKey))
+		}
+	}
+
+	rights := flattenIoTDPSAccessRights(accessPolicy.Rights)
+	d.Set("enrollment_read", rights.enrollmentRead)
+	d.Set("enrollment_write", rights.enrollmentWrite)
+	d.Set("registration_read", rights.registrationRead)
+	d.Set("registration_write", rights.registrationWrite)
+	d.Set("service_config", rights.serviceConfig)
+
+	return nil
+}
+
+func resourceArmIotDPSSharedAccessPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).iothub.DPSResourceClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	iotDPSName := id.Path["provisioningServices"]
+	keyName := id.Path["keys"]
+
+	locks.ByName(iotDPSName, iotDPSResourceName)
+	defer locks.UnlockByName(iotDPSName, iotDPSResourceName)
+
+	iotDPS, err := client.Get(ctx, iotDPSName, resourceGroup)
+	if err != nil {
+		if utils.ResponseWasNotFound(iotDPS.Response) {
+			return fmt.Errorf("IoT Device Provisioning Service %q (Resource Group %q) was not found", iotDPSName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error loading IoT Device Provisioning Service %q (Resource Group %q): %+v", iotDPSName, resourceGroup, err)
+	}
+
+	accessPolicies := make([]iothub.SharedAccessSignatureAuthorizationRuleAccessRightsDescription, 0)
+
+	for accessPolicyIterator, err := client.ListKeysComplete(ctx, iotDPSName, resourceGroup); accessPolicyIterator.NotDone(); err = accessPolicyIterator.NextWithContext(ctx) {
+		if err != nil {
+			return fmt.Errorf("Error loading Shared Access Policies of IoT Device Provisioning Service %q (Resource Group %q): %+v", iotDPSName, resourceGroup, err)
+		}
+		existingAccessPolicy := accessPolicyIterator.Value()
+
+		if !strings.EqualFold(*existingAccessPolicy.KeyName, keyName) {
+			accessPolicies = append(accessPolicies, existingAccessPolicy)
+		}
+	}
+
+	iotDPS.Properties.AuthorizationPolicies = &accessPolicies
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, iotDPSName, iotDPS)
+	if err != nil {
+		return fmt.Errorf("Error removing Shared Access Policy %q from IoT Device Provisioning Service %q (Resource Group %q): %+v", keyName, iotDPSName, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for IoT Device Provisioning Service %q (Resource Group %q) to finish removing Shared Access Policy %q: %+v", iotDPSName, resourceGroup, keyName, err)
+	}
+
+	return nil
+}
+
+type iotDPSAccessRights struct {
+	enrollmentRead    bool
+	enrollmentWrite   bool
+	registrationRead  bool
+	registrationWrite bool
+	serviceConfig     bool
+}
+
+func expandIoTDPSAccessRights(d *schema.ResourceData) string {
+	var possibleAccessRights = []struct {
+		schema string
+		right  iothub.AccessRightsDescription
+	}{
+		{"service_config", iothub.ServiceConfig},
+		{"enrollment_read", iothub.EnrollmentRead},
+		{"enrollment_write", iothub.EnrollmentWrite},
+		{"registration_read", iothub.RegistrationStatusRead},
+		{"registration_write", iothub.RegistrationStatusWrite},
+	}
+	actualRights := make([]string, 0)
+	// iteration order is important here, so we cannot use a map
+	for _, possibleRight := range possibleAccessRights {
+		if d.Get(possibleRight.schema).(bool) {
+			actualRights = append(actualRights, string(possibleRight.right))
+		}
+	}
+	return strings.Join(actualRights, ", ")
+}
+
+func flattenIoTDPSAccessRights(r iothub.AccessRightsDescription) iotDPSAccessRights {
+	rights := iotDPSAccessRights{}
+
+	for _, right := range strings.Split(string(r), ",") {
+		switch strings.ToLower(strings.Trim(right, " ")) {
+		case strings.ToLower(string(iothub.ServiceConfig)):
+			rights.serviceConfig = true
+		case strings.ToLower(string(iothub.EnrollmentRead)):
+			rights.enrollmentRead = true
+		case strings.ToLower(string(iothub.EnrollmentWrite)):
+			rights.enrollmentWrite = true
+		case strings.ToLower(string(iothub.RegistrationStatusRead)):
+			rights.registrationRead = true
+		case strings.ToLower(string(iothub.RegistrationStatusWrite)):
+			rights.registrationWrite = true
+		}
+	}
+
+	return rights
+}
@@ -0,0 +1,218 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMIotDPSSharedAccessPolicy_basic(t *testing.T) {
+	resourceName := "azurerm_iot_dps_shared_access_policy.test"
+	rInt := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIotDPSSharedAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIotDPSSharedAccessPolicy_basic(rInt, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIotDPSSharedAccessPolicyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enrollment_read", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enrollment_write", "false"),
+					resource.TestCheckResourceAttr(resourceName, "registration_read", "false"),
+					resource.TestCheckResourceAttr(resourceName, "registration_write", "false"),
+					resource.TestCheckResourceAttr(resourceName, "service_config", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_connection_string"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMIotDPSSharedAccessPolicy_writeWithoutRead(t *testing.T) {
+	rInt := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIotDPSSharedAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMIotDPSSharedAccessPolicy_writeWithoutRead(rInt, testLocation()),
+				ExpectError: regexp.MustCompile("If `registration_write` is set to true, `registration_read` must also be set to true"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMIotDPSSharedAccessPolicy_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_iot_dps_shared_access_policy.test"
+	rInt := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMIotDPSSharedAccessPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMIotDPSSharedAccessPolicy_basic(rInt, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMIotDPSSharedAccessPolicyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMIotDPSSharedAccessPolicy_requiresImport(rInt, location),
+				ExpectError: testRequiresImportError("azurerm_iot_dps_shared_access_policy"),
+			},
+		},
+	})
+}
+
+func testAccAzureRMIotDPSSharedAccessPolicy_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iot_dps" "test" {
+  name                = "acctestIoTDPS-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+
+  sku {
+    name     = "S1"
+    tier     = "Standard"
+    capacity = "1"
+  }
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMIotDPSSharedAccessPolicy_basic(rInt int, location string) string {
+	template := testAccAzureRMIotDPSSharedAccessPolicy_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iot_dps_shared_access_policy" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  iot_dps_name        = "${azurerm_iot_dps.test.name}"
+  name                = "acctest"
+  service_config      = true
+}
+`, template)
+}
+
+func testAccAzureRMIotDPSSharedAccessPolicy_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMIotDPSSharedAccessPolicy_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iot_dps_shared_access_policy" "import" {
+  resource_group_name = "${azurerm_iot_dps_shared_access_policy.test.resource_group_name}"
+  iot_dps_name        = "${azurerm_iot_dps_shared_access_policy.test.iot_dps_name}"
+  name                = "${azurerm_iot_dps_shared_access_policy.test.name}"
+  service_config      = true
+}
+`, template)
+}
+
+func testAccAzureRMIotDPSSharedAccessPolicy_writeWithoutRead(rInt int, location string) string {
+	template := testAccAzureRMIotDPSSharedAccessPolicy_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iot_dps_shared_access_policy" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  iot_dps_name        = "${azurerm_iot_dps.test.name}"
+  name                = "acctest"
+  registration_write  = true
+}
+`, template)
+}
+
+func testCheckAzureRMIotDPSSharedAccessPolicyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		iotDPSName := id.Path["provisioningServices"]
+		keyName := id.Path["keys"]
+		resourceGroup := id.ResourceGroup
+
+		client := testAccProvider.Meta().(*ArmClient).iothub.DPSResourceClient
+
+		for accessPolicyIterator, err := client.ListKeysComplete(ctx, iotDPSName, resourceGroup); accessPolicyIterator.NotDone(); err = accessPolicyIterator.NextWithContext(ctx) {
+			if err != nil {
+				return fmt.Errorf("Error loading Shared Access Policies of IoT Device Provisioning Service %q (Resource Group %q): %+v", iotDPSName, resourceGroup, err)
+			}
+
+			if strings.EqualFold(*accessPolicyIterator.Value().KeyName, keyName) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Bad: No shared access policy %s defined for IoT Device Provisioning Service %s", keyName, iotDPSName)
+	}
+}
+
+func testCheckAzureRMIotDPSSharedAccessPolicyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).iothub.DPSResourceClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_iot_dps_shared_access_policy" {
+			continue
+		}
+
+		keyName := rs.Primary.Attributes["name"]
+		iotDPSName := rs.Primary.Attributes["iot_dps_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, iotDPSName, resourceGroup)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("Bad: Get on iothub.DPSResourceClient: %+v", err)
+		}
+
+		if props := resp.Properties; props != nil && props.AuthorizationPolicies != nil {
+			for _, sharedAccessPolicy := range *props.AuthorizationPolicies {
+				if strings.EqualFold(*sharedAccessPolicy.KeyName, keyName) {
+					return fmt.Errorf("Bad: Shared Access Policy %s still exists on IoT Device Provisioning Service %s", keyName, iotDPSName)
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -24,6 +24,10 @@ func TestAccAzureRMIotDPS_basic(t *testing.T) {
 				Config: testAccAzureRMIotDPS_basic(rInt, testLocation()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMIotDPSExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allocation_policy", "Hashed"),
+					resource.TestCheckResourceAttrSet(resourceName, "device_provisioning_host_name"),
+					resource.TestCheckResourceAttrSet(resourceName, "id_scope"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_operations_host_name"),
 				),
 			},
 			{
@@ -88,6 +92,7 @@ func TestAccAzureRMIotDPS_update(t *testing.T) {
 				Config: testAccAzureRMIotDPS_update(rInt, testLocation()),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMIotDPSExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allocation_policy", "GeoLatency"),
 				),
 			},
 			{
@@ -246,6 +251,8 @@ resource "azurerm_iot_dps" "test" {
     capacity = "1"
   }
 
+  allocation_policy = "GeoLatency"
+
   tags = {
     purpose = "testing"
   }
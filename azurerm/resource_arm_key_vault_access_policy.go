@@ -1,10 +1,13 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	uuid "github.com/satori/go.uuid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -12,9 +15,12 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2018-02-14/keyvault"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -28,14 +34,22 @@ func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"key_vault_id": {
-				Type:          schema.TypeString,
-				Optional:      true, //todo required in 2.0
-				Computed:      true, //todo removed in 2.0
-				ForceNew:      true,
-				ValidateFunc:  azure.ValidateResourceID,
-				ConflictsWith: []string{"vault_name"},
+				Type:             schema.TypeString,
+				Optional:         true, //todo required in 2.0
+				Computed:         true, //todo removed in 2.0
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				ConflictsWith:    []string{"vault_name"},
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			//todo remove in 2.0
@@ -110,7 +124,20 @@ func resourceArmKeyVaultAccessPolicy() *schema.Resource {
 
 func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta interface{}, action keyvault.AccessPolicyUpdateKind) error {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	var ctx context.Context
+	var cancel context.CancelFunc
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	switch action {
+	case keyvault.Add:
+		ctx, cancel = timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+		timeout = d.Timeout(schema.TimeoutCreate)
+	case keyvault.Remove:
+		ctx, cancel = timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+		timeout = d.Timeout(schema.TimeoutDelete)
+	default:
+		ctx, cancel = timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	}
+	defer cancel()
 	log.Printf("[INFO] Preparing arguments for Key Vault Access Policy: %s.", action)
 
 	vaultId := d.Get("key_vault_id").(string)
@@ -147,6 +174,11 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 		return fmt.Errorf("one of `resource_group_name` must be set when `vault_name` is used")
 	}
 
+	// Locking to prevent parallel changes to the Access Policies on the same Key Vault overwriting each other,
+	// which needs to happen prior to retrieving the Key Vault so that the existing Access Policies are current
+	locks.ByName(vaultName, keyVaultResourceName)
+	defer locks.UnlockByName(vaultName, keyVaultResourceName)
+
 	keyVault, err := client.Get(ctx, resourceGroup, vaultName)
 	if err != nil {
 		// If the key vault does not exist but this is not a new resource, the policy
@@ -170,10 +202,6 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 		resourceId = fmt.Sprintf("%s/applicationId/%s", resourceId, applicationIdRaw)
 	}
 
-	// Locking to prevent parallel changes causing issues
-	locks.ByName(vaultName, keyVaultResourceName)
-	defer locks.UnlockByName(vaultName, keyVaultResourceName)
-
 	if features.ShouldResourcesBeImported() && d.IsNewResource() {
 		props := keyVault.Properties
 		if props == nil {
@@ -244,10 +272,29 @@ func resourceArmKeyVaultAccessPolicyCreateOrDelete(d *schema.ResourceData, meta
 		},
 	}
 
-	if _, err = client.UpdateAccessPolicy(ctx, resourceGroup, vaultName, action, parameters); err != nil {
+	if err := resource.Retry(timeout, retryKeyVaultAccessPolicyUpdate(ctx, client, resourceGroup, vaultName, action, parameters)); err != nil {
 		return fmt.Errorf("Error updating Access Policy (Object ID %q / Application ID %q) for Key Vault %q (Resource Group %q): %+v", objectId, applicationIdRaw, vaultName, resourceGroup, err)
 	}
 
+	// the Access Policies are eventually consistent, so we need to wait for the change to be visible
+	// to avoid the Read (or other Access Policies being applied to this Key Vault) seeing stale data
+	targetState := "present"
+	if action == keyvault.Remove {
+		targetState = "absent"
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{"present", "absent"},
+		Target:                    []string{targetState},
+		Refresh:                   keyVaultAccessPolicyRefreshFunc(ctx, client, resourceGroup, vaultName, objectId, applicationIdRaw),
+		Timeout:                   timeout,
+		Delay:                     5 * time.Second,
+		MinTimeout:                5 * time.Second,
+		ContinuousTargetOccurence: 3,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Access Policy (Object ID %q / Application ID %q) for Key Vault %q (Resource Group %q) to become %s: %+v", objectId, applicationIdRaw, vaultName, resourceGroup, targetState, err)
+	}
+
 	read, err := client.Get(ctx, resourceGroup, vaultName)
 	if err != nil {
 		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
@@ -278,7 +325,8 @@ func resourceArmKeyVaultAccessPolicyUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmKeyVaultAccessPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).keyvault.VaultsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 
@@ -372,3 +420,43 @@ func findKeyVaultAccessPolicy(policies *[]keyvault.AccessPolicyEntry, objectId s
 
 	return nil, nil
 }
+
+func retryKeyVaultAccessPolicyUpdate(ctx context.Context, client *keyvault.VaultsClient, resourceGroup string, vaultName string, action keyvault.AccessPolicyUpdateKind, parameters keyvault.VaultAccessPolicyParameters) func() *resource.RetryError {
+	return func() *resource.RetryError {
+		resp, err := client.UpdateAccessPolicy(ctx, resourceGroup, vaultName, action, parameters)
+		if err != nil {
+			// the Key Vault can be locked by another operation (e.g. an update to the Key Vault itself made outside of Terraform)
+			if utils.ResponseErrorIsRetryable(err) || utils.ResponseWasStatusCode(resp.Response, http.StatusConflict) {
+				return resource.RetryableError(err)
+			}
+
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	}
+}
+
+func keyVaultAccessPolicyRefreshFunc(ctx context.Context, client *keyvault.VaultsClient, resourceGroup string, vaultName string, objectId string, applicationId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		read, err := client.Get(ctx, resourceGroup, vaultName)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", vaultName, resourceGroup, err)
+		}
+
+		if read.Properties == nil {
+			return read, "absent", nil
+		}
+
+		policy, err := findKeyVaultAccessPolicy(read.Properties.AccessPolicies, objectId, applicationId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if policy == nil {
+			return read, "absent", nil
+		}
+
+		return read, "present", nil
+	}
+}
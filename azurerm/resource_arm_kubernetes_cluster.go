@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/containerservice/mgmt/2019-06-01/containerservice"
@@ -17,9 +19,14 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+// kubernetesClusterManagedIdentityClientId is the Client ID used in the Service Principal Profile
+// of clusters which authenticate using a Managed Identity
+const kubernetesClusterManagedIdentityClientId = "msi"
+
 func resourceArmKubernetesCluster() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmKubernetesClusterCreateUpdate,
@@ -31,6 +38,19 @@ func resourceArmKubernetesCluster() *schema.Resource {
 		},
 
 		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// the blocks can be unknown at plan time (e.g. when they're interpolated) - in which case
+			// this is checked during the Create/Update instead
+			if diff.NewValueKnown("service_principal") && diff.NewValueKnown("identity") {
+				_, hasServicePrincipal := diff.GetOk("service_principal")
+				_, hasIdentity := diff.GetOk("identity")
+				if !hasServicePrincipal && !hasIdentity {
+					return fmt.Errorf("Either a `service_principal` or an `identity` block must be specified")
+				}
+				if hasServicePrincipal && hasIdentity {
+					return fmt.Errorf("Only one of `service_principal` and `identity` can be specified")
+				}
+			}
+
 			if v, exists := diff.GetOk("network_profile"); exists {
 				rawProfiles := v.([]interface{})
 				if len(rawProfiles) == 0 {
@@ -176,10 +196,11 @@ func resourceArmKubernetesCluster() *schema.Resource {
 						},
 
 						"vnet_subnet_id": {
-							Type:         schema.TypeString,
-							Optional:     true,
-							ForceNew:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     azure.ValidateResourceID,
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
 						"os_type": {
@@ -213,7 +234,7 @@ func resourceArmKubernetesCluster() *schema.Resource {
 			// TODO: 2.0 - we should be able to make this a List to be able to detect changes in the Client Secret
 			"service_principal": {
 				Type:     schema.TypeSet,
-				Required: true,
+				Optional: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -237,6 +258,33 @@ func resourceArmKubernetesCluster() *schema.Resource {
 			},
 
 			// Optional
+			"identity": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(containerservice.SystemAssigned),
+							}, false),
+						},
+						"principal_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tenant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"addon_profile": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -275,9 +323,10 @@ func resourceArmKubernetesCluster() *schema.Resource {
 										Required: true,
 									},
 									"log_analytics_workspace_id": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: azure.ValidateResourceID,
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateFunc:     azure.ValidateResourceID,
+										DiffSuppressFunc: suppress.CaseDifference,
 									},
 								},
 							},
@@ -315,6 +364,79 @@ func resourceArmKubernetesCluster() *schema.Resource {
 								},
 							},
 						},
+
+						"azure_policy": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+								},
+							},
+						},
+
+						"ingress_application_gateway": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"gateway_id": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     azure.ValidateResourceID,
+										ConflictsWith:    []string{"addon_profile.0.ingress_application_gateway.0.subnet_id", "addon_profile.0.ingress_application_gateway.0.subnet_cidr"},
+										DiffSuppressFunc: suppress.CaseDifference,
+									},
+									"subnet_id": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateFunc:     azure.ValidateResourceID,
+										ConflictsWith:    []string{"addon_profile.0.ingress_application_gateway.0.gateway_id", "addon_profile.0.ingress_application_gateway.0.subnet_cidr"},
+										DiffSuppressFunc: suppress.CaseDifference,
+									},
+									"subnet_cidr": {
+										Type:          schema.TypeString,
+										Optional:      true,
+										ValidateFunc:  validate.CIDR,
+										ConflictsWith: []string{"addon_profile.0.ingress_application_gateway.0.gateway_id", "addon_profile.0.ingress_application_gateway.0.subnet_id"},
+									},
+								},
+							},
+						},
+
+						"azure_keyvault_secrets_provider": {
+							Type:     schema.TypeList,
+							MaxItems: 1,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"enabled": {
+										Type:     schema.TypeBool,
+										Required: true,
+									},
+									"secret_rotation_enabled": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+									"secret_rotation_interval": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "2m",
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9]+h)?([0-9]+m)?([0-9]+s)?$`), "`secret_rotation_interval` must be a duration such as `2m` or `1h30m`"),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -624,7 +746,8 @@ func resourceArmKubernetesCluster() *schema.Resource {
 
 func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	tenantId := meta.(*ArmClient).tenantId
 
 	log.Printf("[INFO] preparing arguments for Managed Kubernetes Cluster create/update.")
@@ -692,6 +815,18 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 		Tags: tags.Expand(t),
 	}
 
+	if v, ok := d.GetOk("identity"); ok {
+		parameters.Identity = expandKubernetesClusterManagedClusterIdentity(v.([]interface{}))
+		// when using a Managed Identity the Service Principal is managed by Azure
+		if parameters.ManagedClusterProperties.ServicePrincipalProfile == nil {
+			parameters.ManagedClusterProperties.ServicePrincipalProfile = &containerservice.ManagedClusterServicePrincipalProfile{
+				ClientID: utils.String(kubernetesClusterManagedIdentityClientId),
+			}
+		}
+	} else if servicePrincipalProfile == nil {
+		return fmt.Errorf("Either a `service_principal` or an `identity` block must be specified")
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, parameters)
 	if err != nil {
 		return fmt.Errorf("Error creating/updating Managed Kubernetes Cluster %q (Resource Group %q): %+v", name, resGroup, err)
@@ -717,7 +852,8 @@ func resourceArmKubernetesClusterCreateUpdate(d *schema.ResourceData, meta inter
 
 func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -748,6 +884,10 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
+	if err := d.Set("identity", flattenKubernetesClusterManagedClusterIdentity(resp.Identity)); err != nil {
+		return fmt.Errorf("Error setting `identity`: %+v", err)
+	}
+
 	if props := resp.ManagedClusterProperties; props != nil {
 		d.Set("dns_prefix", props.DNSPrefix)
 		d.Set("fqdn", props.Fqdn)
@@ -824,7 +964,8 @@ func resourceArmKubernetesClusterRead(d *schema.ResourceData, meta interface{})
 
 func resourceArmKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).containers.KubernetesClustersClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -934,6 +1075,55 @@ func expandKubernetesClusterAddonProfiles(d *schema.ResourceData) map[string]*co
 		}
 	}
 
+	azurePolicy := profile["azure_policy"].([]interface{})
+	if len(azurePolicy) > 0 && azurePolicy[0] != nil {
+		value := azurePolicy[0].(map[string]interface{})
+		enabled := value["enabled"].(bool)
+
+		addonProfiles["azurepolicy"] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+			Config:  nil,
+		}
+	}
+
+	ingressApplicationGateway := profile["ingress_application_gateway"].([]interface{})
+	if len(ingressApplicationGateway) > 0 && ingressApplicationGateway[0] != nil {
+		value := ingressApplicationGateway[0].(map[string]interface{})
+		config := make(map[string]*string)
+		enabled := value["enabled"].(bool)
+
+		if gatewayId, ok := value["gateway_id"]; ok && gatewayId != "" {
+			config["applicationGatewayId"] = utils.String(gatewayId.(string))
+		}
+
+		if subnetId, ok := value["subnet_id"]; ok && subnetId != "" {
+			config["subnetId"] = utils.String(subnetId.(string))
+		}
+
+		if subnetCIDR, ok := value["subnet_cidr"]; ok && subnetCIDR != "" {
+			config["subnetCIDR"] = utils.String(subnetCIDR.(string))
+		}
+
+		addonProfiles["ingressApplicationGateway"] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+			Config:  config,
+		}
+	}
+
+	secretsProvider := profile["azure_keyvault_secrets_provider"].([]interface{})
+	if len(secretsProvider) > 0 && secretsProvider[0] != nil {
+		value := secretsProvider[0].(map[string]interface{})
+		enabled := value["enabled"].(bool)
+
+		addonProfiles["azureKeyvaultSecretsProvider"] = &containerservice.ManagedClusterAddonProfile{
+			Enabled: utils.Bool(enabled),
+			Config: map[string]*string{
+				"enableSecretRotation": utils.String(strconv.FormatBool(value["secret_rotation_enabled"].(bool))),
+				"rotationPollInterval": utils.String(value["secret_rotation_interval"].(string)),
+			},
+		}
+	}
+
 	return addonProfiles
 }
 
@@ -1014,6 +1204,78 @@ func flattenKubernetesClusterAddonProfiles(profile map[string]*containerservice.
 	}
 	values["kube_dashboard"] = kubeDashboards
 
+	azurePolicies := make([]interface{}, 0)
+	if azurePolicy := profile["azurepolicy"]; azurePolicy != nil {
+		enabled := false
+		if enabledVal := azurePolicy.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		output := map[string]interface{}{
+			"enabled": enabled,
+		}
+		azurePolicies = append(azurePolicies, output)
+	}
+	values["azure_policy"] = azurePolicies
+
+	ingressApplicationGateways := make([]interface{}, 0)
+	if ingressApplicationGateway := profile["ingressApplicationGateway"]; ingressApplicationGateway != nil {
+		enabled := false
+		if enabledVal := ingressApplicationGateway.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		gatewayId := ""
+		if v := ingressApplicationGateway.Config["applicationGatewayId"]; v != nil {
+			gatewayId = *v
+		}
+
+		subnetId := ""
+		if v := ingressApplicationGateway.Config["subnetId"]; v != nil {
+			subnetId = *v
+		}
+
+		subnetCIDR := ""
+		if v := ingressApplicationGateway.Config["subnetCIDR"]; v != nil {
+			subnetCIDR = *v
+		}
+
+		output := map[string]interface{}{
+			"enabled":     enabled,
+			"gateway_id":  gatewayId,
+			"subnet_id":   subnetId,
+			"subnet_cidr": subnetCIDR,
+		}
+		ingressApplicationGateways = append(ingressApplicationGateways, output)
+	}
+	values["ingress_application_gateway"] = ingressApplicationGateways
+
+	secretsProviders := make([]interface{}, 0)
+	if secretsProvider := profile["azureKeyvaultSecretsProvider"]; secretsProvider != nil {
+		enabled := false
+		if enabledVal := secretsProvider.Enabled; enabledVal != nil {
+			enabled = *enabledVal
+		}
+
+		rotationEnabled := false
+		if v := secretsProvider.Config["enableSecretRotation"]; v != nil {
+			rotationEnabled = strings.EqualFold(*v, "true")
+		}
+
+		rotationInterval := ""
+		if v := secretsProvider.Config["rotationPollInterval"]; v != nil {
+			rotationInterval = *v
+		}
+
+		output := map[string]interface{}{
+			"enabled":                  enabled,
+			"secret_rotation_enabled":  rotationEnabled,
+			"secret_rotation_interval": rotationInterval,
+		}
+		secretsProviders = append(secretsProviders, output)
+	}
+	values["azure_keyvault_secrets_provider"] = secretsProviders
+
 	return []interface{}{values}
 }
 
@@ -1445,6 +1707,11 @@ func flattenAzureRmKubernetesClusterServicePrincipalProfile(profile *containerse
 		return nil
 	}
 
+	// the Service Principal of a cluster using a Managed Identity isn't user-configurable
+	if profile.ClientID != nil && strings.EqualFold(*profile.ClientID, kubernetesClusterManagedIdentityClientId) {
+		return nil
+	}
+
 	servicePrincipalProfiles := &schema.Set{
 		F: resourceKubernetesClusterServicePrincipalProfileHash,
 	}
@@ -1463,6 +1730,42 @@ func flattenAzureRmKubernetesClusterServicePrincipalProfile(profile *containerse
 	return servicePrincipalProfiles
 }
 
+func expandKubernetesClusterManagedClusterIdentity(input []interface{}) *containerservice.ManagedClusterIdentity {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	values := input[0].(map[string]interface{})
+
+	return &containerservice.ManagedClusterIdentity{
+		Type: containerservice.ResourceIdentityType(values["type"].(string)),
+	}
+}
+
+func flattenKubernetesClusterManagedClusterIdentity(input *containerservice.ManagedClusterIdentity) []interface{} {
+	if input == nil || input.Type == containerservice.None {
+		return []interface{}{}
+	}
+
+	principalId := ""
+	if input.PrincipalID != nil {
+		principalId = *input.PrincipalID
+	}
+
+	tenantId := ""
+	if input.TenantID != nil {
+		tenantId = *input.TenantID
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         string(input.Type),
+			"principal_id": principalId,
+			"tenant_id":    tenantId,
+		},
+	}
+}
+
 func resourceKubernetesClusterServicePrincipalProfileHash(v interface{}) int {
 	// TODO: this method should be able to be removed in time
 	var buf bytes.Buffer
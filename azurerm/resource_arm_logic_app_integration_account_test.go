@@ -0,0 +1,181 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestAccAzureRMLogicAppIntegrationAccount_basic(t *testing.T) {
+	resourceName := "azurerm_logic_app_integration_account.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppIntegrationAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppIntegrationAccount_basic(ri, location, "Free"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppIntegrationAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "Free"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogicAppIntegrationAccount_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_logic_app_integration_account.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppIntegrationAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppIntegrationAccount_basic(ri, location, "Free"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppIntegrationAccountExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMLogicAppIntegrationAccount_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_logic_app_integration_account"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLogicAppIntegrationAccount_update(t *testing.T) {
+	resourceName := "azurerm_logic_app_integration_account.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppIntegrationAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppIntegrationAccount_basic(ri, location, "Free"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppIntegrationAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "Free"),
+				),
+			},
+			{
+				Config: testAccAzureRMLogicAppIntegrationAccount_basic(ri, location, "Standard"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppIntegrationAccountExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku_name", "Standard"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMLogicAppIntegrationAccountExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Logic App Integration Account: %s", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).logic.IntegrationAccountsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return fmt.Errorf("Bad: Logic App Integration Account %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+			return fmt.Errorf("Bad: Get on logicIntegrationAccountsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMLogicAppIntegrationAccountDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).logic.IntegrationAccountsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_logic_app_integration_account" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if resp.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Logic App Integration Account still exists: \n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMLogicAppIntegrationAccount_basic(rInt int, location string, sku string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_integration_account" "test" {
+  name                = "acctestia-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku_name            = "%s"
+}
+`, rInt, location, rInt, sku)
+}
+
+func testAccAzureRMLogicAppIntegrationAccount_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMLogicAppIntegrationAccount_basic(rInt, location, "Free")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_integration_account" "import" {
+  name                = "${azurerm_logic_app_integration_account.test.name}"
+  location            = "${azurerm_logic_app_integration_account.test.location}"
+  resource_group_name = "${azurerm_logic_app_integration_account.test.resource_group_name}"
+  sku_name            = "${azurerm_logic_app_integration_account.test.sku_name}"
+}
+`, template)
+}
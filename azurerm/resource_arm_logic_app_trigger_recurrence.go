@@ -3,10 +3,14 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 )
 
 func resourceArmLogicAppTriggerRecurrence() *schema.Resource {
@@ -19,6 +23,13 @@ func resourceArmLogicAppTriggerRecurrence() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -27,10 +38,11 @@ func resourceArmLogicAppTriggerRecurrence() *schema.Resource {
 			},
 
 			"logic_app_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"frequency": {
@@ -51,17 +63,86 @@ func resourceArmLogicAppTriggerRecurrence() *schema.Resource {
 				Type:     schema.TypeInt,
 				Required: true,
 			},
+
+			// the offset is omitted when a `time_zone` is specified, so this can't be validated as RFC3339
+			"start_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(Z|[+-]\d{2}:\d{2})?$`),
+					"`start_time` must be in the format `YYYY-MM-DDThh:mm:ss`, optionally followed by `Z` or an offset",
+				),
+			},
+
+			"time_zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"schedule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"at_these_hours": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 23),
+							},
+						},
+						"at_these_minutes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 59),
+							},
+						},
+						"on_these_days": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.DayOfTheWeek(false),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
 func resourceArmLogicAppTriggerRecurrenceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	frequency := d.Get("frequency").(string)
+	recurrence := map[string]interface{}{
+		"frequency": frequency,
+		"interval":  d.Get("interval").(int),
+	}
+
+	if v, ok := d.GetOk("start_time"); ok {
+		recurrence["startTime"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("time_zone"); ok {
+		recurrence["timeZone"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("schedule"); ok {
+		schedule, err := expandLogicAppTriggerRecurrenceSchedule(frequency, v.([]interface{}))
+		if err != nil {
+			return err
+		}
+		recurrence["schedule"] = schedule
+	}
+
 	trigger := map[string]interface{}{
-		"recurrence": map[string]interface{}{
-			"frequency": d.Get("frequency").(string),
-			"interval":  d.Get("interval").(int),
-		},
-		"type": "Recurrence",
+		"recurrence": recurrence,
+		"type":       "Recurrence",
 	}
 
 	logicAppId := d.Get("logic_app_id").(string)
@@ -83,7 +164,7 @@ func resourceArmLogicAppTriggerRecurrenceRead(d *schema.ResourceData, meta inter
 	logicAppName := id.Path["workflows"]
 	name := id.Path["triggers"]
 
-	t, app, err := retrieveLogicAppTrigger(meta, resourceGroup, logicAppName, name)
+	t, app, err := retrieveLogicAppTrigger(d, meta, resourceGroup, logicAppName, name)
 	if err != nil {
 		return err
 	}
@@ -117,6 +198,19 @@ func resourceArmLogicAppTriggerRecurrenceRead(d *schema.ResourceData, meta inter
 		d.Set("interval", int(interval.(float64)))
 	}
 
+	if startTime := recurrence["startTime"]; startTime != nil {
+		d.Set("start_time", startTime.(string))
+	}
+
+	if timeZone := recurrence["timeZone"]; timeZone != nil {
+		d.Set("time_zone", timeZone.(string))
+	}
+
+	schedule := flattenLogicAppTriggerRecurrenceSchedule(recurrence["schedule"])
+	if err := d.Set("schedule", schedule); err != nil {
+		return fmt.Errorf("Error setting `schedule`: %+v", err)
+	}
+
 	return nil
 }
 
@@ -137,3 +231,75 @@ func resourceArmLogicAppTriggerRecurrenceDelete(d *schema.ResourceData, meta int
 
 	return nil
 }
+
+func expandLogicAppTriggerRecurrenceSchedule(frequency string, input []interface{}) (map[string]interface{}, error) {
+	output := make(map[string]interface{})
+	if len(input) == 0 || input[0] == nil {
+		return output, nil
+	}
+
+	if frequency != "Day" && frequency != "Week" {
+		return nil, fmt.Errorf("`schedule` can only be specified when `frequency` is set to `Day` or `Week`")
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if hours := v["at_these_hours"].(*schema.Set).List(); len(hours) > 0 {
+		output["hours"] = hours
+	}
+
+	if minutes := v["at_these_minutes"].(*schema.Set).List(); len(minutes) > 0 {
+		output["minutes"] = minutes
+	}
+
+	if days := v["on_these_days"].(*schema.Set).List(); len(days) > 0 {
+		if frequency != "Week" {
+			return nil, fmt.Errorf("`on_these_days` can only be specified when `frequency` is set to `Week`")
+		}
+		output["weekDays"] = days
+	}
+
+	return output, nil
+}
+
+func flattenLogicAppTriggerRecurrenceSchedule(input interface{}) []interface{} {
+	schedule, ok := input.(map[string]interface{})
+	if !ok || len(schedule) == 0 {
+		return []interface{}{}
+	}
+
+	hours := make([]interface{}, 0)
+	if v, ok := schedule["hours"].([]interface{}); ok {
+		for _, hour := range v {
+			if h, ok := hour.(float64); ok {
+				hours = append(hours, int(h))
+			}
+		}
+	}
+
+	minutes := make([]interface{}, 0)
+	if v, ok := schedule["minutes"].([]interface{}); ok {
+		for _, minute := range v {
+			if m, ok := minute.(float64); ok {
+				minutes = append(minutes, int(m))
+			}
+		}
+	}
+
+	days := make([]interface{}, 0)
+	if v, ok := schedule["weekDays"].([]interface{}); ok {
+		for _, day := range v {
+			if d, ok := day.(string); ok {
+				days = append(days, d)
+			}
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"at_these_hours":   hours,
+			"at_these_minutes": minutes,
+			"on_these_days":    days,
+		},
+	}
+}
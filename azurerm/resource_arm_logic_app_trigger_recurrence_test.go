@@ -224,6 +224,43 @@ func TestAccAzureRMLogicAppTriggerRecurrence_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMLogicAppTriggerRecurrence_schedule(t *testing.T) {
+	resourceName := "azurerm_logic_app_trigger_recurrence.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppTriggerRecurrence_schedule(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppTriggerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "Week"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "W. Europe Standard Time"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.at_these_hours.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.at_these_minutes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.on_these_days.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMLogicAppTriggerRecurrence_basic(ri, location, "Week", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppTriggerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "schedule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAzureRMLogicAppTriggerRecurrence_basic(rInt int, location, frequency string, interval int) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -259,3 +296,33 @@ resource "azurerm_logic_app_trigger_recurrence" "import" {
 }
 `, template)
 }
+
+func testAccAzureRMLogicAppTriggerRecurrence_schedule(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                = "acctestlaw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_logic_app_trigger_recurrence" "test" {
+  name         = "frequency-trigger"
+  logic_app_id = "${azurerm_logic_app_workflow.test.id}"
+  frequency    = "Week"
+  interval     = 1
+  start_time   = "2020-01-01T09:00:00"
+  time_zone    = "W. Europe Standard Time"
+
+  schedule {
+    at_these_hours   = [9, 17]
+    at_these_minutes = [30]
+    on_these_days    = ["Monday", "Friday"]
+  }
+}
+`, rInt, location, rInt)
+}
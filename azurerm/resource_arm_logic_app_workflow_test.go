@@ -105,6 +105,38 @@ func TestAccAzureRMLogicAppWorkflow_tags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMLogicAppWorkflow_integrationAccount(t *testing.T) {
+	resourceName := "azurerm_logic_app_workflow.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLogicAppWorkflowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLogicAppWorkflow_integrationAccount(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppWorkflowExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "logic_app_integration_account_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMLogicAppWorkflow_empty(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLogicAppWorkflowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "logic_app_integration_account_id", ""),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMLogicAppWorkflowExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -206,3 +238,26 @@ resource "azurerm_logic_app_workflow" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMLogicAppWorkflow_integrationAccount(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_logic_app_integration_account" "test" {
+  name                = "acctestia-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku_name            = "Free"
+}
+
+resource "azurerm_logic_app_workflow" "test" {
+  name                             = "acctestlaw-%d"
+  location                         = "${azurerm_resource_group.test.location}"
+  resource_group_name              = "${azurerm_resource_group.test.name}"
+  logic_app_integration_account_id = "${azurerm_logic_app_integration_account.test.id}"
+}
+`, rInt, location, rInt, rInt)
+}
@@ -0,0 +1,200 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/resources/mgmt/2018-03-01-preview/managementgroups"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmManagementGroupSubscriptionAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmManagementGroupSubscriptionAssociationCreate,
+		Read:   resourceArmManagementGroupSubscriptionAssociationRead,
+		Delete: resourceArmManagementGroupSubscriptionAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"management_group_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateManagementGroupID,
+			},
+
+			"subscription_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.UUID,
+			},
+		},
+	}
+}
+
+func resourceArmManagementGroupSubscriptionAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managementGroups.GroupsClient
+	subscriptionsClient := meta.(*ArmClient).managementGroups.SubscriptionClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	groupId, err := parseManagementGroupId(d.Get("management_group_id").(string))
+	if err != nil {
+		return err
+	}
+	subscriptionId := d.Get("subscription_id").(string)
+
+	resourceId := fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s/subscriptions/%s", groupId.groupId, subscriptionId)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		recurse := false
+		existing, err := client.Get(ctx, groupId.groupId, "children", &recurse, "", managementGroupCacheControl)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Management Group %q: %+v", groupId.groupId, err)
+		}
+
+		if props := existing.Properties; props != nil && managementGroupHasSubscription(props.Children, subscriptionId) {
+			return tf.ImportAsExistsError("azurerm_management_group_subscription_association", resourceId)
+		}
+	}
+
+	log.Printf("[DEBUG] Associating Subscription %q with Management Group %q", subscriptionId, groupId.groupId)
+	if _, err := subscriptionsClient.Create(ctx, groupId.groupId, subscriptionId, managementGroupCacheControl); err != nil {
+		return fmt.Errorf("Error associating Subscription %q with Management Group %q: %+v", subscriptionId, groupId.groupId, err)
+	}
+
+	d.SetId(resourceId)
+
+	return resourceArmManagementGroupSubscriptionAssociationRead(d, meta)
+}
+
+func resourceArmManagementGroupSubscriptionAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).managementGroups.GroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseManagementGroupSubscriptionAssociationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	recurse := false
+	resp, err := client.Get(ctx, id.groupId, "children", &recurse, "", managementGroupCacheControl)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Management Group %q doesn't exist - removing Subscription Association from state", id.groupId)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Management Group %q: %+v", id.groupId, err)
+	}
+
+	if props := resp.Properties; props == nil || !managementGroupHasSubscription(props.Children, id.subscriptionId) {
+		log.Printf("[INFO] Subscription %q is no longer associated with Management Group %q - removing from state", id.subscriptionId, id.groupId)
+		d.SetId("")
+		return nil
+	}
+
+	managementGroupId := fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s", id.groupId)
+	if resp.ID != nil {
+		managementGroupId = *resp.ID
+	}
+
+	d.Set("management_group_id", managementGroupId)
+	d.Set("subscription_id", id.subscriptionId)
+
+	return nil
+}
+
+func resourceArmManagementGroupSubscriptionAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	subscriptionsClient := meta.(*ArmClient).managementGroups.SubscriptionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := parseManagementGroupSubscriptionAssociationId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] De-associating Subscription %q from Management Group %q", id.subscriptionId, id.groupId)
+	// NOTE: whilst this says `Delete` it's actually `Deassociate`
+	resp, err := subscriptionsClient.Delete(ctx, id.groupId, id.subscriptionId, managementGroupCacheControl)
+	if err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error de-associating Subscription %q from Management Group %q: %+v", id.subscriptionId, id.groupId, err)
+		}
+	}
+
+	return nil
+}
+
+func managementGroupHasSubscription(children *[]managementgroups.ChildInfo, subscriptionId string) bool {
+	if children == nil {
+		return false
+	}
+
+	for _, child := range *children {
+		if child.ID == nil {
+			continue
+		}
+
+		id, err := parseManagementGroupSubscriptionID(*child.ID)
+		if err != nil || id == nil {
+			continue
+		}
+
+		if strings.EqualFold(id.subscriptionId, subscriptionId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type managementGroupSubscriptionAssociationId struct {
+	groupId        string
+	subscriptionId string
+}
+
+func parseManagementGroupSubscriptionAssociationId(input string) (*managementGroupSubscriptionAssociationId, error) {
+	// /providers/Microsoft.Management/managementGroups/group1/subscriptions/00000000-0000-0000-0000-000000000000
+	segments := strings.Split(input, "/")
+	if len(segments) != 7 || segments[5] != "subscriptions" {
+		return nil, fmt.Errorf("Expected a Management Group Subscription Association ID in the format `/providers/Microsoft.Management/managementGroups/{groupId}/subscriptions/{subscriptionId}` but got %q", input)
+	}
+
+	return &managementGroupSubscriptionAssociationId{
+		groupId:        segments[4],
+		subscriptionId: segments[6],
+	}, nil
+}
+
+func validateManagementGroupID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !strings.HasPrefix(v, "/providers/Microsoft.Management/managementGroups/") {
+		errors = append(errors, fmt.Errorf("%q must be a Management Group ID in the format `/providers/Microsoft.Management/managementGroups/{groupId}`, got %q", k, v))
+		return warnings, errors
+	}
+
+	if _, err := parseManagementGroupId(v); err != nil {
+		errors = append(errors, fmt.Errorf("Error parsing %q as a Management Group ID: %+v", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,206 @@
+package azurerm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestParseManagementGroupSubscriptionAssociationId(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *managementGroupSubscriptionAssociationId
+	}{
+		{
+			Input:    "",
+			Expected: nil,
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: nil,
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/group1/subscriptions",
+			Expected: nil,
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/group1/resourceGroups/00000000-0000-0000-0000-000000000000",
+			Expected: nil,
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/group1/subscriptions/00000000-0000-0000-0000-000000000000",
+			Expected: &managementGroupSubscriptionAssociationId{
+				groupId:        "group1",
+				subscriptionId: "00000000-0000-0000-0000-000000000000",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := parseManagementGroupSubscriptionAssociationId(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %s", err)
+		}
+
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got a value for %q", v.Input)
+		}
+
+		if actual.groupId != v.Expected.groupId {
+			t.Fatalf("Expected %q but got %q for groupId", v.Expected.groupId, actual.groupId)
+		}
+
+		if actual.subscriptionId != v.Expected.subscriptionId {
+			t.Fatalf("Expected %q but got %q for subscriptionId", v.Expected.subscriptionId, actual.subscriptionId)
+		}
+	}
+}
+
+func TestAccAzureRMManagementGroupSubscriptionAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_management_group_subscription_association.test"
+	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupSubscriptionAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureRMManagementGroupSubscriptionAssociation_basic(subscriptionID),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupSubscriptionAssociationExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagementGroupSubscriptionAssociation_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_management_group_subscription_association.test"
+	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagementGroupSubscriptionAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureRMManagementGroupSubscriptionAssociation_basic(subscriptionID),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagementGroupSubscriptionAssociationExists(resourceName),
+				),
+			},
+			{
+				Config:      testAzureRMManagementGroupSubscriptionAssociation_requiresImport(subscriptionID),
+				ExpectError: testRequiresImportError("azurerm_management_group_subscription_association"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMManagementGroupSubscriptionAssociationExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).managementGroups.GroupsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		id, err := parseManagementGroupSubscriptionAssociationId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		recurse := false
+		resp, err := client.Get(ctx, id.groupId, "children", &recurse, "", managementGroupCacheControl)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on managementGroupsClient: %+v", err)
+		}
+
+		if props := resp.Properties; props == nil || !managementGroupHasSubscription(props.Children, id.subscriptionId) {
+			return fmt.Errorf("Bad: Subscription %q is not associated with Management Group %q", id.subscriptionId, id.groupId)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMManagementGroupSubscriptionAssociationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).managementGroups.GroupsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_management_group_subscription_association" {
+			continue
+		}
+
+		id, err := parseManagementGroupSubscriptionAssociationId(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		recurse := false
+		resp, err := client.Get(ctx, id.groupId, "children", &recurse, "", managementGroupCacheControl)
+		if err != nil {
+			// the Management Group has been removed, so the association has gone too
+			return nil
+		}
+
+		if props := resp.Properties; props != nil && managementGroupHasSubscription(props.Children, id.subscriptionId) {
+			return fmt.Errorf("Subscription %q is still associated with Management Group %q", id.subscriptionId, id.groupId)
+		}
+	}
+
+	return nil
+}
+
+// TODO: switch this out for dynamically creating a subscription once that's supported in the future
+func testAzureRMManagementGroupSubscriptionAssociation_basic(subscriptionID string) string {
+	return fmt.Sprintf(`
+resource "azurerm_management_group" "test" {
+  # subscription_ids is authoritative, so changes made by the association are ignored
+  lifecycle {
+    ignore_changes = ["subscription_ids"]
+  }
+}
+
+resource "azurerm_management_group_subscription_association" "test" {
+  management_group_id = "${azurerm_management_group.test.id}"
+  subscription_id     = "%s"
+}
+`, subscriptionID)
+}
+
+func testAzureRMManagementGroupSubscriptionAssociation_requiresImport(subscriptionID string) string {
+	template := testAzureRMManagementGroupSubscriptionAssociation_basic(subscriptionID)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_group_subscription_association" "import" {
+  management_group_id = "${azurerm_management_group_subscription_association.test.management_group_id}"
+  subscription_id     = "${azurerm_management_group_subscription_association.test.subscription_id}"
+}
+`, template)
+}
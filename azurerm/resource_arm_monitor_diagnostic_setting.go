@@ -13,9 +13,11 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -40,10 +42,11 @@ func resourceArmMonitorDiagnosticSetting() *schema.Resource {
 			},
 
 			"target_resource_id": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"eventhub_name": {
@@ -54,24 +57,27 @@ func resourceArmMonitorDiagnosticSetting() *schema.Resource {
 			},
 
 			"eventhub_authorization_rule_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"log_analytics_workspace_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"storage_account_id": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"log_analytics_destination_type": {
@@ -99,7 +105,7 @@ func resourceArmMonitorDiagnosticSetting() *schema.Resource {
 
 						"retention_policy": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -138,7 +144,7 @@ func resourceArmMonitorDiagnosticSetting() *schema.Resource {
 
 						"retention_policy": {
 							Type:     schema.TypeList,
-							Required: true,
+							Optional: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -164,7 +170,8 @@ func resourceArmMonitorDiagnosticSetting() *schema.Resource {
 
 func resourceArmMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitor.DiagnosticSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	log.Printf("[INFO] preparing arguments for Azure ARM Diagnostic Settings.")
 
 	name := d.Get("name").(string)
@@ -275,7 +282,8 @@ func resourceArmMonitorDiagnosticSettingCreateUpdate(d *schema.ResourceData, met
 
 func resourceArmMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitor.DiagnosticSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseMonitorDiagnosticId(d.Id())
 	if err != nil {
@@ -305,11 +313,11 @@ func resourceArmMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interf
 
 	d.Set("log_analytics_destination_type", resp.LogAnalyticsDestinationType)
 
-	if err := d.Set("log", flattenMonitorDiagnosticLogs(resp.Logs)); err != nil {
+	if err := d.Set("log", flattenMonitorDiagnosticLogs(resp.Logs, d.Get("log").(*schema.Set).List())); err != nil {
 		return fmt.Errorf("Error setting `log`: %+v", err)
 	}
 
-	if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Metrics)); err != nil {
+	if err := d.Set("metric", flattenMonitorDiagnosticMetrics(resp.Metrics, d.Get("metric").(*schema.Set).List())); err != nil {
 		return fmt.Errorf("Error setting `metric`: %+v", err)
 	}
 
@@ -318,7 +326,8 @@ func resourceArmMonitorDiagnosticSettingRead(d *schema.ResourceData, meta interf
 
 func resourceArmMonitorDiagnosticSettingDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).monitor.DiagnosticSettingsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseMonitorDiagnosticId(d.Id())
 	if err != nil {
@@ -373,18 +382,10 @@ func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []insights.LogSet
 		category := v["category"].(string)
 		enabled := v["enabled"].(bool)
 
-		policiesRaw := v["retention_policy"].([]interface{})
-		policyRaw := policiesRaw[0].(map[string]interface{})
-		retentionDays := policyRaw["days"].(int)
-		retentionEnabled := policyRaw["enabled"].(bool)
-
 		output := insights.LogSettings{
-			Category: utils.String(category),
-			Enabled:  utils.Bool(enabled),
-			RetentionPolicy: &insights.RetentionPolicy{
-				Days:    utils.Int32(int32(retentionDays)),
-				Enabled: utils.Bool(retentionEnabled),
-			},
+			Category:        utils.String(category),
+			Enabled:         utils.Bool(enabled),
+			RetentionPolicy: expandMonitorDiagnosticsSettingsRetentionPolicy(v["retention_policy"].([]interface{})),
 		}
 
 		results = append(results, output)
@@ -393,12 +394,14 @@ func expandMonitorDiagnosticsSettingsLogs(input []interface{}) []insights.LogSet
 	return results
 }
 
-func flattenMonitorDiagnosticLogs(input *[]insights.LogSettings) []interface{} {
+func flattenMonitorDiagnosticLogs(input *[]insights.LogSettings, existing []interface{}) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
+	retentionPolicyConfigured := monitorDiagnosticCategoriesWithRetentionPolicy(existing)
+
 	for _, v := range *input {
 		output := make(map[string]interface{})
 
@@ -410,23 +413,7 @@ func flattenMonitorDiagnosticLogs(input *[]insights.LogSettings) []interface{} {
 			output["enabled"] = *v.Enabled
 		}
 
-		policies := make([]interface{}, 0)
-
-		if inputPolicy := v.RetentionPolicy; inputPolicy != nil {
-			outputPolicy := make(map[string]interface{})
-
-			if inputPolicy.Days != nil {
-				outputPolicy["days"] = int(*inputPolicy.Days)
-			}
-
-			if inputPolicy.Enabled != nil {
-				outputPolicy["enabled"] = *inputPolicy.Enabled
-			}
-
-			policies = append(policies, outputPolicy)
-		}
-
-		output["retention_policy"] = policies
+		output["retention_policy"] = flattenMonitorDiagnosticRetentionPolicy(v.RetentionPolicy, retentionPolicyConfigured == nil || retentionPolicyConfigured[output["category"]])
 
 		results = append(results, output)
 	}
@@ -443,19 +430,10 @@ func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []insights.Met
 		category := v["category"].(string)
 		enabled := v["enabled"].(bool)
 
-		policiesRaw := v["retention_policy"].([]interface{})
-		policyRaw := policiesRaw[0].(map[string]interface{})
-
-		retentionDays := policyRaw["days"].(int)
-		retentionEnabled := policyRaw["enabled"].(bool)
-
 		output := insights.MetricSettings{
-			Category: utils.String(category),
-			Enabled:  utils.Bool(enabled),
-			RetentionPolicy: &insights.RetentionPolicy{
-				Days:    utils.Int32(int32(retentionDays)),
-				Enabled: utils.Bool(retentionEnabled),
-			},
+			Category:        utils.String(category),
+			Enabled:         utils.Bool(enabled),
+			RetentionPolicy: expandMonitorDiagnosticsSettingsRetentionPolicy(v["retention_policy"].([]interface{})),
 		}
 
 		results = append(results, output)
@@ -464,12 +442,14 @@ func expandMonitorDiagnosticsSettingsMetrics(input []interface{}) []insights.Met
 	return results
 }
 
-func flattenMonitorDiagnosticMetrics(input *[]insights.MetricSettings) []interface{} {
+func flattenMonitorDiagnosticMetrics(input *[]insights.MetricSettings, existing []interface{}) []interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
 	}
 
+	retentionPolicyConfigured := monitorDiagnosticCategoriesWithRetentionPolicy(existing)
+
 	for _, v := range *input {
 		output := make(map[string]interface{})
 
@@ -481,28 +461,80 @@ func flattenMonitorDiagnosticMetrics(input *[]insights.MetricSettings) []interfa
 			output["enabled"] = *v.Enabled
 		}
 
-		policies := make([]interface{}, 0)
+		output["retention_policy"] = flattenMonitorDiagnosticRetentionPolicy(v.RetentionPolicy, retentionPolicyConfigured == nil || retentionPolicyConfigured[output["category"]])
+
+		results = append(results, output)
+	}
 
-		if inputPolicy := v.RetentionPolicy; inputPolicy != nil {
-			outputPolicy := make(map[string]interface{})
+	return results
+}
 
-			if inputPolicy.Days != nil {
-				outputPolicy["days"] = int(*inputPolicy.Days)
-			}
+func expandMonitorDiagnosticsSettingsRetentionPolicy(input []interface{}) *insights.RetentionPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
 
-			if inputPolicy.Enabled != nil {
-				outputPolicy["enabled"] = *inputPolicy.Enabled
-			}
+	v := input[0].(map[string]interface{})
+	retentionDays := v["days"].(int)
+	retentionEnabled := v["enabled"].(bool)
 
-			policies = append(policies, outputPolicy)
-		}
+	return &insights.RetentionPolicy{
+		Days:    utils.Int32(int32(retentionDays)),
+		Enabled: utils.Bool(retentionEnabled),
+	}
+}
+
+func flattenMonitorDiagnosticRetentionPolicy(input *insights.RetentionPolicy, configured bool) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
 
-		output["retention_policy"] = policies
+	days := 0
+	if input.Days != nil {
+		days = int(*input.Days)
+	}
 
-		results = append(results, output)
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
 	}
 
-	return results
+	// the API returns a disabled Retention Policy when none is specified (and now that Retention Policies are
+	// being retired, may remove one which was specified) - so only surface this when it's meaningful or
+	// was previously configured, to avoid a perpetual diff
+	if !enabled && days == 0 && !configured {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"days":    days,
+			"enabled": enabled,
+		},
+	}
+}
+
+// monitorDiagnosticCategoriesWithRetentionPolicy returns the categories which have a `retention_policy` block
+// defined in the existing `log` or `metric` blocks - or nil when there's nothing in the state (e.g. during import)
+func monitorDiagnosticCategoriesWithRetentionPolicy(input []interface{}) map[interface{}]bool {
+	if len(input) == 0 {
+		return nil
+	}
+
+	output := make(map[interface{}]bool)
+
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if policies, ok := v["retention_policy"].([]interface{}); ok && len(policies) > 0 {
+			output[v["category"]] = true
+		}
+	}
+
+	return output
 }
 
 type monitorDiagnosticId struct {
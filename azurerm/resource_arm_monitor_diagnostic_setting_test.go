@@ -165,6 +165,28 @@ func TestAccAzureRMMonitorDiagnosticSetting_storageAccount(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMMonitorDiagnosticSetting_withoutRetentionPolicy(t *testing.T) {
+	resourceName := "azurerm_monitor_diagnostic_setting.test"
+	ri := acctest.RandIntRange(10000, 99999)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMMonitorDiagnosticSettingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMonitorDiagnosticSetting_withoutRetentionPolicy(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMonitorDiagnosticSettingExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "log.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMMonitorDiagnosticSettingExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -492,3 +514,47 @@ resource "azurerm_monitor_diagnostic_setting" "test" {
 }
 `, rInt, location, rInt, rInt, rInt)
 }
+
+func testAccAzureRMMonitorDiagnosticSetting_withoutRetentionPolicy(rInt int, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctest%d"
+  location = "%s"
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestlaw%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                = "acctestkv%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  tenant_id           = "${data.azurerm_client_config.current.tenant_id}"
+
+  sku {
+    name = "standard"
+  }
+}
+
+resource "azurerm_monitor_diagnostic_setting" "test" {
+  name                       = "acctestds%d"
+  target_resource_id         = "${azurerm_key_vault.test.id}"
+  log_analytics_workspace_id = "${azurerm_log_analytics_workspace.test.id}"
+
+  log {
+    category = "AuditEvent"
+    enabled  = false
+  }
+
+  metric {
+    category = "AllMetrics"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -77,7 +78,8 @@ func resourceArmRecoveryNetworkMappingCreate(d *schema.ResourceData, meta interf
 	name := d.Get("name").(string)
 
 	client := meta.(*ArmClient).recoveryServices.NetworkMappingClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	//get network name from id
 	parsedSourceNetworkId, err := azure.ParseAzureResourceID(sourceNetworkId)
@@ -145,7 +147,8 @@ func resourceArmRecoveryNetworkMappingRead(d *schema.ResourceData, meta interfac
 	name := id.Path["replicationNetworkMappings"]
 
 	client := meta.(*ArmClient).recoveryServices.NetworkMappingClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resp, err := client.Get(ctx, fabricName, networkName, name)
 	if err != nil {
@@ -187,7 +190,8 @@ func resourceArmRecoveryNetworkMappingDelete(d *schema.ResourceData, meta interf
 	name := id.Path["replicationNetworkMappings"]
 
 	client := meta.(*ArmClient).recoveryServices.NetworkMappingClient(resGroup, vaultName)
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	future, err := client.Delete(ctx, fabricName, networkName, name)
 	if err != nil {
@@ -424,9 +424,10 @@ func testAccAzureRMRecoveryServicesProtectionPolicyVm_completeDaily(rInt int, lo
 %s
 
 resource "azurerm_recovery_services_protection_policy_vm" "test" {
-  name                = "acctest-%d"
-  resource_group_name = "${azurerm_resource_group.test.name}"
-  recovery_vault_name = "${azurerm_recovery_services_vault.test.name}"
+  name                           = "acctest-%d"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  recovery_vault_name            = "${azurerm_recovery_services_vault.test.name}"
+  instant_restore_retention_days = 5
 
   backup {
     frequency = "Daily"
@@ -466,6 +467,7 @@ func checkAccAzureRMRecoveryServicesProtectionPolicyVm_completeDaily(resourceNam
 		resource.TestCheckResourceAttr(resourceName, "recovery_vault_name", fmt.Sprintf("acctest-%d", ri)),
 		resource.TestCheckResourceAttr(resourceName, "backup.0.frequency", "Daily"),
 		resource.TestCheckResourceAttr(resourceName, "backup.0.time", "23:00"),
+		resource.TestCheckResourceAttr(resourceName, "instant_restore_retention_days", "5"),
 		resource.TestCheckResourceAttr(resourceName, "retention_daily.0.count", "10"),
 		resource.TestCheckResourceAttr(resourceName, "retention_weekly.0.count", "42"),
 		resource.TestCheckResourceAttr(resourceName, "retention_weekly.0.weekdays.#", "2"),
@@ -0,0 +1,252 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/relay/mgmt/2017-04-01/relay"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// the authorization rules which are managed on each Hybrid Connection, used to expose
+// the listener and sender connection strings
+const (
+	relayHybridConnectionListenerAuthorizationRule = "Listener"
+	relayHybridConnectionSenderAuthorizationRule   = "Sender"
+)
+
+func resourceArmRelayHybridConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRelayHybridConnectionCreateUpdate,
+		Read:   resourceArmRelayHybridConnectionRead,
+		Update: resourceArmRelayHybridConnectionCreateUpdate,
+		Delete: resourceArmRelayHybridConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"relay_namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(6, 50),
+			},
+
+			"requires_client_authorization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				ForceNew: true,
+			},
+
+			"user_metadata": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// changing any value in this map regenerates both the primary and secondary keys
+			"rotate_when_changed": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"listener_primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"listener_secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"sender_primary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"sender_secondary_connection_string": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmRelayHybridConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relay.HybridConnectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	namespaceName := d.Get("relay_namespace_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_relay_hybrid_connection", *existing.ID)
+		}
+	}
+
+	parameters := relay.HybridConnection{
+		HybridConnectionProperties: &relay.HybridConnectionProperties{
+			RequiresClientAuthorization: utils.Bool(d.Get("requires_client_authorization").(bool)),
+			UserMetadata:                utils.String(d.Get("user_metadata").(string)),
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, resourceGroup, namespaceName, name, parameters); err != nil {
+		return fmt.Errorf("Error creating/updating Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Relay Hybrid Connection %q (Namespace %q / Resource Group %q) ID", name, namespaceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	authorizationRules := map[string]relay.AccessRights{
+		relayHybridConnectionListenerAuthorizationRule: relay.Listen,
+		relayHybridConnectionSenderAuthorizationRule:   relay.Send,
+	}
+	for ruleName, rights := range authorizationRules {
+		rule := relay.AuthorizationRule{
+			AuthorizationRuleProperties: &relay.AuthorizationRuleProperties{
+				Rights: &[]relay.AccessRights{rights},
+			},
+		}
+		if _, err := client.CreateOrUpdateAuthorizationRule(ctx, resourceGroup, namespaceName, name, ruleName, rule); err != nil {
+			return fmt.Errorf("Error creating/updating Authorization Rule %q for Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", ruleName, name, namespaceName, resourceGroup, err)
+		}
+
+		if !d.IsNewResource() && d.HasChange("rotate_when_changed") {
+			if err := regenerateRelayHybridConnectionKeys(ctx, client, resourceGroup, namespaceName, name, ruleName); err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceArmRelayHybridConnectionRead(d, meta)
+}
+
+func resourceArmRelayHybridConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relay.HybridConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["hybridConnections"]
+
+	resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Relay Hybrid Connection %q (Namespace %q / Resource Group %q) was not found - removing from state", name, namespaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("relay_namespace_name", namespaceName)
+
+	if props := resp.HybridConnectionProperties; props != nil {
+		d.Set("requires_client_authorization", props.RequiresClientAuthorization)
+		d.Set("user_metadata", props.UserMetadata)
+	}
+
+	listenerKeys, err := client.ListKeys(ctx, resourceGroup, namespaceName, name, relayHybridConnectionListenerAuthorizationRule)
+	if err != nil && !utils.ResponseWasNotFound(listenerKeys.Response) {
+		return fmt.Errorf("Error listing the keys of Authorization Rule %q for Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", relayHybridConnectionListenerAuthorizationRule, name, namespaceName, resourceGroup, err)
+	}
+	d.Set("listener_primary_connection_string", listenerKeys.PrimaryConnectionString)
+	d.Set("listener_secondary_connection_string", listenerKeys.SecondaryConnectionString)
+
+	senderKeys, err := client.ListKeys(ctx, resourceGroup, namespaceName, name, relayHybridConnectionSenderAuthorizationRule)
+	if err != nil && !utils.ResponseWasNotFound(senderKeys.Response) {
+		return fmt.Errorf("Error listing the keys of Authorization Rule %q for Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", relayHybridConnectionSenderAuthorizationRule, name, namespaceName, resourceGroup, err)
+	}
+	d.Set("sender_primary_connection_string", senderKeys.PrimaryConnectionString)
+	d.Set("sender_secondary_connection_string", senderKeys.SecondaryConnectionString)
+
+	return nil
+}
+
+func resourceArmRelayHybridConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).relay.HybridConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["hybridConnections"]
+
+	if resp, err := client.Delete(ctx, resourceGroup, namespaceName, name); err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("Error deleting Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func regenerateRelayHybridConnectionKeys(ctx context.Context, client *relay.HybridConnectionsClient, resourceGroup, namespaceName, name, ruleName string) error {
+	for _, keyType := range []relay.KeyType{relay.PrimaryKey, relay.SecondaryKey} {
+		log.Printf("[DEBUG] Regenerating the %s of Authorization Rule %q for Relay Hybrid Connection %q (Namespace %q / Resource Group %q)", string(keyType), ruleName, name, namespaceName, resourceGroup)
+		parameters := relay.RegenerateAccessKeyParameters{
+			KeyType: keyType,
+		}
+		if _, err := client.RegenerateKeys(ctx, resourceGroup, namespaceName, name, ruleName, parameters); err != nil {
+			return fmt.Errorf("Error regenerating the %s of Authorization Rule %q for Relay Hybrid Connection %q (Namespace %q / Resource Group %q): %+v", string(keyType), ruleName, name, namespaceName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
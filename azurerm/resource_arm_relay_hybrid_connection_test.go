@@ -0,0 +1,279 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestAccAzureRMRelayHybridConnection_basic(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayHybridConnection_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "requires_client_authorization", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "listener_primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "listener_secondary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "sender_primary_connection_string"),
+					resource.TestCheckResourceAttrSet(resourceName, "sender_secondary_connection_string"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRelayHybridConnection_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_relay_hybrid_connection.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayHybridConnection_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMRelayHybridConnection_requiresImport(ri, testLocation()),
+				ExpectError: testRequiresImportError("azurerm_relay_hybrid_connection"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRelayHybridConnection_update(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayHybridConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_metadata", ""),
+				),
+			},
+			{
+				Config: testAccAzureRMRelayHybridConnection_userMetadata(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_metadata", "testmetadata"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRelayHybridConnection_rotateKeys(t *testing.T) {
+	resourceName := "azurerm_relay_hybrid_connection.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	connectionStrings := []string{
+		"listener_primary_connection_string",
+		"listener_secondary_connection_string",
+		"sender_primary_connection_string",
+		"sender_secondary_connection_string",
+	}
+	previous := make(map[string]string)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayHybridConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayHybridConnection_rotateKeys(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						for _, key := range connectionStrings {
+							previous[key] = attrs[key]
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAzureRMRelayHybridConnection_rotateKeys(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayHybridConnectionExists(resourceName),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						for _, key := range connectionStrings {
+							if attrs[key] == previous[key] {
+								return fmt.Errorf("Expected the key used in `%s` to have been regenerated", key)
+							}
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRelayHybridConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		namespaceName := rs.Primary.Attributes["relay_namespace_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).relay.HybridConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on relayHybridConnectionsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Relay Hybrid Connection %q (Namespace %q / Resource Group: %q) does not exist", name, namespaceName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMRelayHybridConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).relay.HybridConnectionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_relay_hybrid_connection" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		namespaceName := rs.Primary.Attributes["relay_namespace_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Relay Hybrid Connection still exists:\n%#v", resp)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMRelayHybridConnection_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku_name = "Standard"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMRelayHybridConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMRelayHybridConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctestrnhc-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  relay_namespace_name = "${azurerm_relay_namespace.test.name}"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMRelayHybridConnection_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMRelayHybridConnection_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_hybrid_connection" "import" {
+  name                 = "${azurerm_relay_hybrid_connection.test.name}"
+  resource_group_name  = "${azurerm_relay_hybrid_connection.test.resource_group_name}"
+  relay_namespace_name = "${azurerm_relay_hybrid_connection.test.relay_namespace_name}"
+}
+`, template)
+}
+
+func testAccAzureRMRelayHybridConnection_userMetadata(rInt int, location string) string {
+	template := testAccAzureRMRelayHybridConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctestrnhc-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  relay_namespace_name = "${azurerm_relay_namespace.test.name}"
+  user_metadata        = "testmetadata"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMRelayHybridConnection_rotateKeys(rInt int, location string, rotation string) string {
+	template := testAccAzureRMRelayHybridConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_relay_hybrid_connection" "test" {
+  name                 = "acctestrnhc-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  relay_namespace_name = "${azurerm_relay_namespace.test.name}"
+
+  rotate_when_changed = {
+    rotation = "%s"
+  }
+}
+`, template, rInt, rotation)
+}
@@ -18,9 +18,12 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const relayNamespaceDefaultAuthorizationRule = "RootManageSharedAccessKey"
+
 func resourceArmRelayNamespace() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmRelayNamespaceCreateUpdate,
@@ -103,6 +106,15 @@ func resourceArmRelayNamespace() *schema.Resource {
 				Sensitive: true,
 			},
 
+			// changing any value in this map regenerates both the primary and secondary keys
+			"rotate_when_changed": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -110,7 +122,8 @@ func resourceArmRelayNamespace() *schema.Resource {
 
 func resourceArmRelayNamespaceCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).relay.NamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	// Remove in 2.0
 	var sku relay.Sku
@@ -183,12 +196,25 @@ func resourceArmRelayNamespaceCreateUpdate(d *schema.ResourceData, meta interfac
 
 	d.SetId(*read.ID)
 
+	if !d.IsNewResource() && d.HasChange("rotate_when_changed") {
+		for _, keyType := range []relay.KeyType{relay.PrimaryKey, relay.SecondaryKey} {
+			log.Printf("[DEBUG] Regenerating the %s for Relay Namespace %q (Resource Group %q)", string(keyType), name, resourceGroup)
+			parameters := relay.RegenerateAccessKeyParameters{
+				KeyType: keyType,
+			}
+			if _, err := client.RegenerateKeys(ctx, resourceGroup, name, relayNamespaceDefaultAuthorizationRule, parameters); err != nil {
+				return fmt.Errorf("Error regenerating the %s for Relay Namespace %q (Resource Group %q): %+v", string(keyType), name, resourceGroup, err)
+			}
+		}
+	}
+
 	return resourceArmRelayNamespaceRead(d, meta)
 }
 
 func resourceArmRelayNamespaceRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).relay.NamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -230,7 +256,7 @@ func resourceArmRelayNamespaceRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("metric_id", props.MetricID)
 	}
 
-	keysResp, err := client.ListKeys(ctx, resourceGroup, name, "RootManageSharedAccessKey")
+	keysResp, err := client.ListKeys(ctx, resourceGroup, name, relayNamespaceDefaultAuthorizationRule)
 	if err != nil {
 		return fmt.Errorf("Error making ListKeys request on Relay Namespace %q (Resource Group %q): %s", name, resourceGroup, err)
 	}
@@ -245,7 +271,8 @@ func resourceArmRelayNamespaceRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmRelayNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).relay.NamespacesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
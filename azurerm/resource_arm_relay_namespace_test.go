@@ -152,6 +152,49 @@ func TestAccAzureRMRelayNamespace_complete(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMRelayNamespace_rotateKeys(t *testing.T) {
+	resourceName := "azurerm_relay_namespace.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	var primaryKey, secondaryKey string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRelayNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRelayNamespace_rotateKeys(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayNamespaceExists(resourceName),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						primaryKey = attrs["primary_key"]
+						secondaryKey = attrs["secondary_key"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccAzureRMRelayNamespace_rotateKeys(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRelayNamespaceExists(resourceName),
+					func(s *terraform.State) error {
+						attrs := s.RootModule().Resources[resourceName].Primary.Attributes
+						if attrs["primary_key"] == primaryKey {
+							return fmt.Errorf("Expected the `primary_key` to have been regenerated")
+						}
+						if attrs["secondary_key"] == secondaryKey {
+							return fmt.Errorf("Expected the `secondary_key` to have been regenerated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMRelayNamespaceExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -291,3 +334,24 @@ resource "azurerm_relay_namespace" "test" {
 }
 `, rInt, location, rInt)
 }
+
+func testAccAzureRMRelayNamespace_rotateKeys(rInt int, location string, rotation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_relay_namespace" "test" {
+  name                = "acctestrn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku_name = "Standard"
+
+  rotate_when_changed = {
+    rotation = "%s"
+  }
+}
+`, rInt, location, rInt, rotation)
+}
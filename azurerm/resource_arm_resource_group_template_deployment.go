@@ -0,0 +1,354 @@
+package azurerm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2018-05-01/resources"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmResourceGroupTemplateDeployment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmResourceGroupTemplateDeploymentCreateUpdate,
+		Read:   resourceArmResourceGroupTemplateDeploymentRead,
+		Update: resourceArmResourceGroupTemplateDeploymentCreateUpdate,
+		Delete: resourceArmResourceGroupTemplateDeploymentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"deployment_mode": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(resources.Complete),
+					string(resources.Incremental),
+				}, false),
+			},
+
+			"template_content": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				StateFunc:        normalizeJson,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"parameters_content": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				StateFunc:        normalizeJson,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			"debug_level": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"none",
+					"requestContent",
+					"responseContent",
+					"requestContent, responseContent",
+				}, false),
+			},
+
+			"output_content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmResourceGroupTemplateDeploymentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resource.DeploymentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_resource_group_template_deployment", *existing.ID)
+		}
+	}
+
+	template, err := expandResourceGroupTemplateDeploymentContent(d.Get("template_content").(string))
+	if err != nil {
+		return fmt.Errorf("Error expanding `template_content`: %+v", err)
+	}
+
+	deployment := resources.Deployment{
+		Properties: &resources.DeploymentProperties{
+			Mode:     resources.DeploymentMode(d.Get("deployment_mode").(string)),
+			Template: template,
+		},
+	}
+
+	if v, ok := d.GetOk("parameters_content"); ok && v.(string) != "" {
+		parameters, err := expandResourceGroupTemplateDeploymentContent(v.(string))
+		if err != nil {
+			return fmt.Errorf("Error expanding `parameters_content`: %+v", err)
+		}
+
+		deployment.Properties.Parameters = parameters
+	}
+
+	if v, ok := d.GetOk("debug_level"); ok {
+		deployment.Properties.DebugSetting = &resources.DebugSetting{
+			DetailLevel: utils.String(v.(string)),
+		}
+	}
+
+	// validating the template up-front means that errors are surfaced prior to any resources being changed,
+	// which is particularly important when using the `Complete` deployment mode
+	log.Printf("[DEBUG] Validating Template Deployment %q (Resource Group %q)..", name, resourceGroup)
+	validationResult, err := client.Validate(ctx, resourceGroup, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error validating Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if validationResult.Error != nil {
+		return fmt.Errorf("Error validating Template Deployment %q (Resource Group %q): %s", name, resourceGroup, flattenResourceGroupTemplateDeploymentError(*validationResult.Error))
+	}
+
+	log.Printf("[DEBUG] Deploying Template Deployment %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, deployment)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ID for Template Deployment %q (Resource Group %q)", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmResourceGroupTemplateDeploymentRead(d, meta)
+}
+
+func resourceArmResourceGroupTemplateDeploymentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resource.DeploymentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["deployments"]
+
+	resp, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Template Deployment %q was not found in Resource Group %q - removing from state!", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	// the Template isn't returned by the Get, however it can be retrieved using the Export API
+	templateResp, err := client.ExportTemplate(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error exporting the Template for Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+
+	templateContent, err := flattenResourceGroupTemplateDeploymentContent(templateResp.Template)
+	if err != nil {
+		return fmt.Errorf("Error flattening `template_content`: %+v", err)
+	}
+	d.Set("template_content", templateContent)
+
+	if props := resp.Properties; props != nil {
+		d.Set("deployment_mode", string(props.Mode))
+
+		debugLevel := ""
+		if setting := props.DebugSetting; setting != nil && setting.DetailLevel != nil {
+			debugLevel = *setting.DetailLevel
+		}
+		d.Set("debug_level", debugLevel)
+
+		parametersContent, err := flattenResourceGroupTemplateDeploymentParameters(props.Parameters, d.Get("parameters_content").(string))
+		if err != nil {
+			return fmt.Errorf("Error flattening `parameters_content`: %+v", err)
+		}
+		d.Set("parameters_content", parametersContent)
+
+		outputContent, err := flattenResourceGroupTemplateDeploymentOutputs(props.Outputs)
+		if err != nil {
+			return fmt.Errorf("Error flattening `output_content`: %+v", err)
+		}
+		d.Set("output_content", outputContent)
+	}
+
+	return nil
+}
+
+func resourceArmResourceGroupTemplateDeploymentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).resource.DeploymentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["deployments"]
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Template Deployment %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return waitForTemplateDeploymentToBeDeleted(ctx, client, resourceGroup, name)
+}
+
+func expandResourceGroupTemplateDeploymentContent(input string) (map[string]interface{}, error) {
+	var output map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &output); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenResourceGroupTemplateDeploymentContent(input interface{}) (string, error) {
+	if input == nil {
+		return "", nil
+	}
+
+	output, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+// flattenResourceGroupTemplateDeploymentParameters returns the Parameters in the same format they're sent, removing
+// the `type` which the API adds - since the values of Secure Parameters aren't returned these are taken from the config
+func flattenResourceGroupTemplateDeploymentParameters(input interface{}, existing string) (string, error) {
+	parameters, ok := input.(map[string]interface{})
+	if !ok || len(parameters) == 0 {
+		return "", nil
+	}
+
+	existingParameters := make(map[string]interface{})
+	if existing != "" {
+		if err := json.Unmarshal([]byte(existing), &existingParameters); err != nil {
+			return "", err
+		}
+	}
+
+	output := make(map[string]interface{})
+	for key, v := range parameters {
+		parameter, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if parameterType, ok := parameter["type"].(string); ok && strings.HasPrefix(strings.ToLower(parameterType), "secure") {
+			if existingValue, ok := existingParameters[key]; ok {
+				output[key] = existingValue
+			}
+			continue
+		}
+
+		delete(parameter, "type")
+		output[key] = parameter
+	}
+
+	return flattenResourceGroupTemplateDeploymentContent(output)
+}
+
+// flattenResourceGroupTemplateDeploymentOutputs returns the Outputs as JSON keyed by name, removing the `type`
+// so that the value can be retrieved directly using `jsondecode`
+func flattenResourceGroupTemplateDeploymentOutputs(input interface{}) (string, error) {
+	outputs, ok := input.(map[string]interface{})
+	if !ok {
+		return "{}", nil
+	}
+
+	output := make(map[string]interface{})
+	for key, v := range outputs {
+		outputValue, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		output[key] = outputValue["value"]
+	}
+
+	return flattenResourceGroupTemplateDeploymentContent(output)
+}
+
+func flattenResourceGroupTemplateDeploymentError(input resources.ManagementErrorWithDetails) string {
+	code := ""
+	if input.Code != nil {
+		code = *input.Code
+	}
+
+	message := ""
+	if input.Message != nil {
+		message = *input.Message
+	}
+
+	output := fmt.Sprintf("%s: %s", code, message)
+	if details := input.Details; details != nil {
+		for _, detail := range *details {
+			output += fmt.Sprintf("\n - %s", flattenResourceGroupTemplateDeploymentError(detail))
+		}
+	}
+
+	return output
+}
@@ -0,0 +1,407 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestFlattenResourceGroupTemplateDeploymentParameters(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    interface{}
+		Existing string
+		Expected string
+	}{
+		{
+			Name:     "No Parameters",
+			Input:    nil,
+			Existing: "",
+			Expected: "",
+		},
+		{
+			Name: "Type is removed",
+			Input: map[string]interface{}{
+				"someParam": map[string]interface{}{
+					"type":  "String",
+					"value": "first",
+				},
+			},
+			Existing: "",
+			Expected: `{"someParam":{"value":"first"}}`,
+		},
+		{
+			Name: "Secure Parameters are taken from the existing value",
+			Input: map[string]interface{}{
+				"password": map[string]interface{}{
+					"type": "SecureString",
+				},
+			},
+			Existing: `{"password":{"value":"P@ssw0rd1234!"}}`,
+			Expected: `{"password":{"value":"P@ssw0rd1234!"}}`,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual, err := flattenResourceGroupTemplateDeploymentParameters(v.Input, v.Existing)
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestFlattenResourceGroupTemplateDeploymentOutputs(t *testing.T) {
+	input := map[string]interface{}{
+		"testBool": map[string]interface{}{
+			"type":  "Bool",
+			"value": true,
+		},
+		"testString": map[string]interface{}{
+			"type":  "String",
+			"value": "first",
+		},
+	}
+
+	actual, err := flattenResourceGroupTemplateDeploymentOutputs(input)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := `{"testBool":true,"testString":"first"}`
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestAccAzureRMResourceGroupTemplateDeployment_basic(t *testing.T) {
+	resourceName := "azurerm_resource_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroupTemplateDeployment_basic(ri, location, "Complete"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResourceGroupTemplateDeployment_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_resource_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroupTemplateDeployment_basic(ri, location, "Incremental"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupTemplateDeploymentExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMResourceGroupTemplateDeployment_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_resource_group_template_deployment"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResourceGroupTemplateDeployment_update(t *testing.T) {
+	resourceName := "azurerm_resource_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroupTemplateDeployment_basic(ri, location, "Incremental"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "Incremental"),
+				),
+			},
+			{
+				Config: testAccAzureRMResourceGroupTemplateDeployment_basic(ri, location, "Complete"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupTemplateDeploymentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_mode", "Complete"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResourceGroupTemplateDeployment_withParametersAndOutputs(t *testing.T) {
+	resourceName := "azurerm_resource_group_template_deployment.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMResourceGroupTemplateDeployment_withParametersAndOutputs(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupTemplateDeploymentExists(resourceName),
+					resource.TestCheckOutput("test_string", "first"),
+					resource.TestCheckOutput("test_int", "123"),
+					resource.TestCheckOutput("test_bool", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMResourceGroupTemplateDeployment_withError(t *testing.T) {
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupTemplateDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMResourceGroupTemplateDeployment_withError(ri, location),
+				ExpectError: regexp.MustCompile("Error validating Template Deployment"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMResourceGroupTemplateDeploymentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).resource.DeploymentsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Template Deployment %q (Resource Group %q) does not exist", name, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on deploymentsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMResourceGroupTemplateDeploymentDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).resource.DeploymentsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_resource_group_template_deployment" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Template Deployment %q (Resource Group %q) still exists", name, resourceGroup)
+	}
+
+	return nil
+}
+
+func testAccAzureRMResourceGroupTemplateDeployment_basic(rInt int, location string, deploymentMode string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "%s"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {},
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+}
+`, rInt, location, rInt, deploymentMode)
+}
+
+func testAccAzureRMResourceGroupTemplateDeployment_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMResourceGroupTemplateDeployment_basic(rInt, location, "Incremental")
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_group_template_deployment" "import" {
+  name                = "${azurerm_resource_group_template_deployment.test.name}"
+  resource_group_name = "${azurerm_resource_group_template_deployment.test.resource_group_name}"
+  deployment_mode     = "${azurerm_resource_group_template_deployment.test.deployment_mode}"
+  template_content    = "${azurerm_resource_group_template_deployment.test.template_content}"
+}
+`, template)
+}
+
+func testAccAzureRMResourceGroupTemplateDeployment_withParametersAndOutputs(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "someParam": {
+      "type": "String",
+      "allowedValues": [
+        "first",
+        "second",
+        "third"
+      ]
+    }
+  },
+  "variables": {},
+  "resources": [],
+  "outputs": {
+    "testString": {
+      "type": "String",
+      "value": "[parameters('someParam')]"
+    },
+    "testInt": {
+      "type": "Int",
+      "value": 123
+    },
+    "testBool": {
+      "type": "Bool",
+      "value": true
+    }
+  }
+}
+TEMPLATE
+
+  parameters_content = <<PARAMETERS
+{
+  "someParam": {
+    "value": "first"
+  }
+}
+PARAMETERS
+}
+
+output "test_string" {
+  value = "${jsondecode(azurerm_resource_group_template_deployment.test.output_content).testString}"
+}
+
+output "test_int" {
+  value = "${jsondecode(azurerm_resource_group_template_deployment.test.output_content).testInt}"
+}
+
+output "test_bool" {
+  value = "${jsondecode(azurerm_resource_group_template_deployment.test.output_content).testBool}"
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMResourceGroupTemplateDeployment_withError(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group_template_deployment" "test" {
+  name                = "acctest-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  deployment_mode     = "Incremental"
+
+  template_content = <<TEMPLATE
+{
+  "$schema": "https://schema.management.azure.com/schemas/2015-01-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "parameters": {
+    "someParam": {
+      "type": "String"
+    }
+  },
+  "variables": {},
+  "resources": []
+}
+TEMPLATE
+}
+`, rInt, location, rInt)
+}
@@ -214,6 +214,7 @@ func testAccAzureRMActiveDirectoryServicePrincipal_servicePrincipal(t *testing.T
 				Config: testAccAzureRMRoleAssignment_servicePrincipal(ri, id),
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMRoleAssignmentExists("azurerm_role_assignment.test"),
+					resource.TestCheckResourceAttr("azurerm_role_assignment.test", "principal_type", "ServicePrincipal"),
 				),
 			},
 		},
@@ -437,6 +438,7 @@ resource "azurerm_role_assignment" "test" {
   scope                = "${data.azurerm_subscription.current.id}"
   role_definition_name = "Reader"
   principal_id         = "${azuread_service_principal.test.id}"
+  principal_type       = "ServicePrincipal"
 }
 `, rInt, roleAssignmentID)
 }
@@ -126,6 +126,59 @@ func TestAccAzureRMRoleDefinition_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMRoleDefinition_managementGroup(t *testing.T) {
+	resourceName := "azurerm_role_definition.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRoleDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRoleDefinition_managementGroup(uuid.New().String(), ri),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRoleDefinitionExists(resourceName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"role_definition_id"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMRoleDefinition_resourceGroup(t *testing.T) {
+	resourceName := "azurerm_role_definition.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRoleDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the ID returned from Azure is Subscription scoped, so this ensures the scope doesn't force a new resource
+				Config: testAccAzureRMRoleDefinition_resourceGroup(uuid.New().String(), ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRoleDefinitionExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "scope", "azurerm_resource_group.test", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"role_definition_id", "scope"},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMRoleDefinition_updateEmptyId(t *testing.T) {
 	resourceName := "azurerm_role_definition.test"
 	ri := tf.AccRandTimeInt()
@@ -358,3 +411,53 @@ resource "azurerm_role_definition" "test" {
 }
 `, rInt)
 }
+
+func testAccAzureRMRoleDefinition_managementGroup(id string, rInt int) string {
+	return fmt.Sprintf(`
+data "azurerm_subscription" "primary" {}
+
+resource "azurerm_management_group" "test" {
+  group_id = "acctestmg-%d"
+}
+
+resource "azurerm_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = "${azurerm_management_group.test.id}"
+
+  permissions {
+    actions     = ["*"]
+    not_actions = []
+  }
+
+  assignable_scopes = [
+    "${azurerm_management_group.test.id}",
+    "${data.azurerm_subscription.primary.id}",
+  ]
+}
+`, rInt, id, rInt)
+}
+
+func testAccAzureRMRoleDefinition_resourceGroup(id string, rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_role_definition" "test" {
+  role_definition_id = "%s"
+  name               = "acctestrd-%d"
+  scope              = "${azurerm_resource_group.test.id}"
+
+  permissions {
+    actions     = ["*"]
+    not_actions = []
+  }
+
+  assignable_scopes = [
+    "${azurerm_resource_group.test.id}",
+  ]
+}
+`, rInt, location, id, rInt)
+}
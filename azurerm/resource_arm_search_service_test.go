@@ -80,6 +80,9 @@ func TestAccAzureRMSearchService_complete(t *testing.T) {
 					testCheckAzureRMSearchServiceExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "replica_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "identity.0.type", "SystemAssigned"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.principal_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "identity.0.tenant_id"),
 					resource.TestCheckResourceAttrSet(resourceName, "primary_key"),
 					resource.TestCheckResourceAttrSet(resourceName, "secondary_key"),
 				),
@@ -230,6 +233,10 @@ resource "azurerm_search_service" "test" {
   sku                 = "standard"
   replica_count       = 2
 
+  identity {
+    type = "SystemAssigned"
+  }
+
   tags = {
     environment = "production"
   }
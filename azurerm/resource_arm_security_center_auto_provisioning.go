@@ -0,0 +1,115 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// NOTE: 'default' is the only valid name currently supported by the API
+// No other names can be created and the 'default' resource can not be destroyed
+const securityCenterAutoProvisioningName = "default"
+
+func resourceArmSecurityCenterAutoProvisioning() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSecurityCenterAutoProvisioningUpdate,
+		Read:   resourceArmSecurityCenterAutoProvisioningRead,
+		Update: resourceArmSecurityCenterAutoProvisioningUpdate,
+		Delete: resourceArmSecurityCenterAutoProvisioningDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"auto_provision": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(security.AutoProvisionOn),
+					string(security.AutoProvisionOff),
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceArmSecurityCenterAutoProvisioningUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenter.AutoProvisioningClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := securityCenterAutoProvisioningName
+
+	// not doing import check as afaik it always exists (cannot be deleted)
+	// all this resource does is flip a boolean
+
+	setting := security.AutoProvisioningSetting{
+		AutoProvisioningSettingProperties: &security.AutoProvisioningSettingProperties{
+			AutoProvision: security.AutoProvision(d.Get("auto_provision").(string)),
+		},
+	}
+
+	if _, err := client.Create(ctx, name, setting); err != nil {
+		return fmt.Errorf("Error creating/updating Security Center Auto Provisioning: %+v", err)
+	}
+
+	resp, err := client.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("Error reading Security Center Auto Provisioning: %+v", err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("Security Center Auto Provisioning ID is nil")
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmSecurityCenterAutoProvisioningRead(d, meta)
+}
+
+func resourceArmSecurityCenterAutoProvisioningRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenter.AutoProvisioningClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	resp, err := client.Get(ctx, securityCenterAutoProvisioningName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Security Center Auto Provisioning was not found: %v", err)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error reading Security Center Auto Provisioning: %+v", err)
+	}
+
+	if properties := resp.AutoProvisioningSettingProperties; properties != nil {
+		d.Set("auto_provision", string(properties.AutoProvision))
+	}
+
+	return nil
+}
+
+func resourceArmSecurityCenterAutoProvisioningDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).securityCenter.AutoProvisioningClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	// the setting can't be deleted, so we turn auto provisioning off instead
+	setting := security.AutoProvisioningSetting{
+		AutoProvisioningSettingProperties: &security.AutoProvisioningSettingProperties{
+			AutoProvision: security.AutoProvisionOff,
+		},
+	}
+
+	if _, err := client.Create(ctx, securityCenterAutoProvisioningName, setting); err != nil {
+		return fmt.Errorf("Error resetting Security Center Auto Provisioning to 'Off': %+v", err)
+	}
+
+	return nil
+}
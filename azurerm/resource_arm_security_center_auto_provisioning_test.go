@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testAccAzureRMSecurityCenterAutoProvisioning_update(t *testing.T) {
+	resourceName := "azurerm_security_center_auto_provisioning.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMSecurityCenterAutoProvisioning_setting("On"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSecurityCenterAutoProvisioningExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_provision", "On"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAzureRMSecurityCenterAutoProvisioning_setting("Off"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSecurityCenterAutoProvisioningExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "auto_provision", "Off"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSecurityCenterAutoProvisioningExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).securityCenter.AutoProvisioningClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		if _, ok := s.RootModule().Resources[resourceName]; !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resp, err := client.Get(ctx, securityCenterAutoProvisioningName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Security Center Auto Provisioning %q was not found: %+v", securityCenterAutoProvisioningName, err)
+			}
+
+			return fmt.Errorf("Bad: Get on AutoProvisioningClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSecurityCenterAutoProvisioning_setting(setting string) string {
+	return fmt.Sprintf(`
+resource "azurerm_security_center_auto_provisioning" "test" {
+  auto_provision = "%s"
+}
+`, setting)
+}
@@ -8,6 +8,9 @@ func TestAccAzureRMSecurityCenter_pricingAndWorkspace(t *testing.T) {
 	// NOTE: this is a combined test rather than separate split out tests
 	// due to the workspace tests depending on the current pricing tier
 	testCases := map[string]map[string]func(t *testing.T){
+		"autoProvisioning": {
+			"update": testAccAzureRMSecurityCenterAutoProvisioning_update,
+		},
 		"pricing": {
 			"update": testAccAzureRMSecurityCenterSubscriptionPricing_update,
 		},
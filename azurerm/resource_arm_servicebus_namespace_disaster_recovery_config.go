@@ -0,0 +1,225 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/servicebus/mgmt/2017-04-01/servicebus"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmServiceBusNamespaceDisasterRecoveryConfig() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmServiceBusNamespaceDisasterRecoveryConfigCreate,
+		Read:   resourceArmServiceBusNamespaceDisasterRecoveryConfigRead,
+		Update: resourceArmServiceBusNamespaceDisasterRecoveryConfigUpdate,
+		Delete: resourceArmServiceBusNamespaceDisasterRecoveryConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateServiceBusAuthorizationRuleName(),
+			},
+
+			"namespace_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateServiceBusNamespaceName(),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"partner_namespace_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"alternate_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateServiceBusNamespaceName(),
+			},
+
+			"role": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmServiceBusNamespaceDisasterRecoveryConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicebus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+	log.Printf("[INFO] preparing arguments for AzureRM ServiceBus Namespace Disaster Recovery Config creation.")
+
+	name := d.Get("name").(string)
+	namespaceName := d.Get("namespace_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing ServiceBus Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q): %s", name, namespaceName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_servicebus_namespace_disaster_recovery_config", *existing.ID)
+		}
+	}
+
+	partnerNamespaceId := d.Get("partner_namespace_id").(string)
+	alternateName := d.Get("alternate_name").(string)
+	if err := namespaceDisasterRecoveryConfigPair(ctx, serviceBusNamespaceDisasterRecoveryConfigClient(client), resourceGroup, namespaceName, name, partnerNamespaceId, alternateName); err != nil {
+		return err
+	}
+
+	read, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving ServiceBus Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read ServiceBus Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q) ID", name, namespaceName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
+}
+
+func resourceArmServiceBusNamespaceDisasterRecoveryConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicebus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["disasterRecoveryConfigs"]
+
+	drClient := serviceBusNamespaceDisasterRecoveryConfigClient(client)
+	if d.HasChange("partner_namespace_id") {
+		// the pairing has to be broken before the alias can be pointed at a different partner namespace
+		if err := namespaceDisasterRecoveryConfigBreakPairing(ctx, drClient, resourceGroup, namespaceName, name); err != nil {
+			return err
+		}
+	}
+
+	partnerNamespaceId := d.Get("partner_namespace_id").(string)
+	alternateName := d.Get("alternate_name").(string)
+	if err := namespaceDisasterRecoveryConfigPair(ctx, drClient, resourceGroup, namespaceName, name, partnerNamespaceId, alternateName); err != nil {
+		return err
+	}
+
+	return resourceArmServiceBusNamespaceDisasterRecoveryConfigRead(d, meta)
+}
+
+func resourceArmServiceBusNamespaceDisasterRecoveryConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicebus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["disasterRecoveryConfigs"]
+
+	resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] ServiceBus Namespace Disaster Recovery Config %q was not found in Namespace %q / Resource Group %q - removing from state", name, namespaceName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on ServiceBus Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q): %+v", name, namespaceName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("namespace_name", namespaceName)
+	d.Set("resource_group_name", resourceGroup)
+
+	if props := resp.ArmDisasterRecoveryProperties; props != nil {
+		d.Set("partner_namespace_id", props.PartnerNamespace)
+		d.Set("alternate_name", props.AlternateName)
+		d.Set("role", string(props.Role))
+	}
+
+	return nil
+}
+
+func resourceArmServiceBusNamespaceDisasterRecoveryConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).servicebus.DisasterRecoveryConfigsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	namespaceName := id.Path["namespaces"]
+	name := id.Path["disasterRecoveryConfigs"]
+
+	return namespaceDisasterRecoveryConfigDelete(ctx, serviceBusNamespaceDisasterRecoveryConfigClient(client), resourceGroup, namespaceName, name)
+}
+
+func serviceBusNamespaceDisasterRecoveryConfigClient(client *servicebus.DisasterRecoveryConfigsClient) namespaceDisasterRecoveryConfigClient {
+	return namespaceDisasterRecoveryConfigClient{
+		namespaceType: "ServiceBus",
+		createOrUpdate: func(ctx context.Context, resourceGroup, namespaceName, name, partnerNamespaceId, alternateName string) error {
+			parameters := servicebus.ArmDisasterRecovery{
+				ArmDisasterRecoveryProperties: &servicebus.ArmDisasterRecoveryProperties{
+					PartnerNamespace: utils.String(partnerNamespaceId),
+				},
+			}
+
+			if alternateName != "" {
+				parameters.ArmDisasterRecoveryProperties.AlternateName = utils.String(alternateName)
+			}
+
+			_, err := client.CreateOrUpdate(ctx, resourceGroup, namespaceName, name, parameters)
+			return err
+		},
+		provisioningState: func(ctx context.Context, resourceGroup, namespaceName, name string) (autorest.Response, string, error) {
+			resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+			if err != nil {
+				return resp.Response, "", err
+			}
+
+			if props := resp.ArmDisasterRecoveryProperties; props != nil {
+				return resp.Response, string(props.ProvisioningState), nil
+			}
+
+			return resp.Response, string(servicebus.Accepted), nil
+		},
+		breakPairing: client.BreakPairing,
+		delete:       client.Delete,
+	}
+}
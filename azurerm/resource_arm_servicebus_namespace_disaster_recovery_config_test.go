@@ -0,0 +1,248 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_basic(t *testing.T) {
+	resourceName := "azurerm_servicebus_namespace_disaster_recovery_config.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceDisasterRecoveryConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_basic(ri, testLocation(), testAltLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceDisasterRecoveryConfigExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "role", "Primary"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_servicebus_namespace_disaster_recovery_config.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceDisasterRecoveryConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_basic(ri, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceDisasterRecoveryConfigExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_requiresImport(ri, location, altLocation),
+				ExpectError: testRequiresImportError("azurerm_servicebus_namespace_disaster_recovery_config"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_updatePartner(t *testing.T) {
+	resourceName := "azurerm_servicebus_namespace_disaster_recovery_config.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusNamespaceDisasterRecoveryConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_updated(ri, location, altLocation, "secondary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceDisasterRecoveryConfigExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_updated(ri, location, altLocation, "tertiary"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusNamespaceDisasterRecoveryConfigExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMServiceBusNamespaceDisasterRecoveryConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).servicebus.DisasterRecoveryConfigsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_servicebus_namespace_disaster_recovery_config" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		namespaceName := id.Path["namespaces"]
+		name := id.Path["disasterRecoveryConfigs"]
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMServiceBusNamespaceDisasterRecoveryConfigExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).servicebus.DisasterRecoveryConfigsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		namespaceName := id.Path["namespaces"]
+		name := id.Path["disasterRecoveryConfigs"]
+
+		resp, err := client.Get(ctx, resourceGroup, namespaceName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: ServiceBus Namespace Disaster Recovery Config %q (Namespace %q / Resource Group %q) does not exist", name, namespaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on servicebus.DisasterRecoveryConfigsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_basic(rInt int, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "primary" {
+  name                = "acctest-SBN-%d-primary"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Premium"
+  capacity            = 1
+}
+
+resource "azurerm_servicebus_namespace" "secondary" {
+  name                = "acctest-SBN-%d-secondary"
+  location            = "%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Premium"
+  capacity            = 1
+}
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "test" {
+  name                 = "acctest-SBN-DRC-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  namespace_name       = "${azurerm_servicebus_namespace.primary.name}"
+  partner_namespace_id = "${azurerm_servicebus_namespace.secondary.id}"
+}
+`, rInt, location, rInt, rInt, altLocation, rInt)
+}
+
+func testAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_requiresImport(rInt int, location string, altLocation string) string {
+	template := testAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_basic(rInt, location, altLocation)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "import" {
+  name                 = "${azurerm_servicebus_namespace_disaster_recovery_config.test.name}"
+  resource_group_name  = "${azurerm_servicebus_namespace_disaster_recovery_config.test.resource_group_name}"
+  namespace_name       = "${azurerm_servicebus_namespace_disaster_recovery_config.test.namespace_name}"
+  partner_namespace_id = "${azurerm_servicebus_namespace_disaster_recovery_config.test.partner_namespace_id}"
+}
+`, template)
+}
+
+func testAccAzureRMServiceBusNamespaceDisasterRecoveryConfig_updated(rInt int, location string, altLocation string, partner string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_servicebus_namespace" "primary" {
+  name                = "acctest-SBN-%d-primary"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Premium"
+  capacity            = 1
+}
+
+resource "azurerm_servicebus_namespace" "secondary" {
+  name                = "acctest-SBN-%d-secondary"
+  location            = "%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Premium"
+  capacity            = 1
+}
+
+resource "azurerm_servicebus_namespace" "tertiary" {
+  name                = "acctest-SBN-%d-tertiary"
+  location            = "%s"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Premium"
+  capacity            = 1
+}
+
+resource "azurerm_servicebus_namespace_disaster_recovery_config" "test" {
+  name                 = "acctest-SBN-DRC-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  namespace_name       = "${azurerm_servicebus_namespace.primary.name}"
+  partner_namespace_id = "${azurerm_servicebus_namespace.%s.id}"
+}
+`, rInt, location, rInt, rInt, altLocation, rInt, altLocation, rInt, partner)
+}
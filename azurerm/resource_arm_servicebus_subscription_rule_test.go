@@ -113,6 +113,34 @@ func TestAccAzureRMServiceBusSubscriptionRule_correlationFilterWithAction(t *tes
 	})
 }
 
+func TestAccAzureRMServiceBusSubscriptionRule_correlationFilterWithProperties(t *testing.T) {
+	resourceName := "azurerm_servicebus_subscription_rule.test"
+	ri := tf.AccRandTimeInt()
+	config := testAccAzureRMServiceBusSubscriptionRule_correlationFilterWithProperties(ri, testLocation())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMServiceBusTopicDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMServiceBusSubscriptionRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "correlation_filter.0.properties.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "correlation_filter.0.properties.region", "westeurope"),
+					resource.TestCheckResourceAttr(resourceName, "correlation_filter.0.properties.priority", "high"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMServiceBusSubscriptionRule_sqlFilterUpdated(t *testing.T) {
 	resourceName := "azurerm_servicebus_subscription_rule.test"
 	ri := tf.AccRandTimeInt()
@@ -395,6 +423,32 @@ resource "azurerm_servicebus_subscription_rule" "test" {
 `, template, rInt)
 }
 
+func testAccAzureRMServiceBusSubscriptionRule_correlationFilterWithProperties(rInt int, location string) string {
+	template := testAccAzureRMServiceBusSubscriptionRule_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_servicebus_subscription_rule" "test" {
+  name                = "acctestservicebusrule-%d"
+  namespace_name      = "${azurerm_servicebus_namespace.test.name}"
+  topic_name          = "${azurerm_servicebus_topic.test.name}"
+  subscription_name   = "${azurerm_servicebus_subscription.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  action              = "SET Routed='true'"
+  filter_type         = "CorrelationFilter"
+
+  correlation_filter {
+    label = "order"
+
+    properties = {
+      region   = "westeurope"
+      priority = "high"
+    }
+  }
+}
+`, template, rInt)
+}
+
 func testAccAzureRMServiceBusSubscriptionRule_template(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
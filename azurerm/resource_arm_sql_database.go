@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -124,9 +125,11 @@ func resourceArmSqlDatabase() *schema.Resource {
 			},
 
 			"source_database_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     azure.ValidateResourceIDOrEmpty,
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"restore_point_in_time": {
@@ -336,7 +339,8 @@ func resourceArmSqlDatabase() *schema.Resource {
 
 func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sql.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	serverName := d.Get("server_name").(string)
@@ -373,7 +377,16 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	if v, ok := d.GetOk("source_database_id"); ok {
 		sourceDatabaseID := v.(string)
-		properties.DatabaseProperties.SourceDatabaseID = utils.String(sourceDatabaseID)
+		if err := validateSqlDatabaseSourceDatabaseID(createMode, sourceDatabaseID); err != nil {
+			return err
+		}
+
+		// a Long Term Retention Backup is restored from a Recovery Point rather than a Database
+		if strings.EqualFold(createMode, string(sql.RestoreLongTermRetentionBackup)) {
+			properties.DatabaseProperties.RecoveryServicesRecoveryPointResourceID = utils.String(sourceDatabaseID)
+		} else {
+			properties.DatabaseProperties.SourceDatabaseID = utils.String(sourceDatabaseID)
+		}
 	}
 
 	if v, ok := d.GetOk("edition"); ok {
@@ -494,7 +507,8 @@ func resourceArmSqlDatabaseCreateUpdate(d *schema.ResourceData, meta interface{}
 
 func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sql.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -575,7 +589,8 @@ func resourceArmSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 
 func resourceArmSqlDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).sql.DatabasesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -731,3 +746,34 @@ func expandArmSqlServerThreatDetectionPolicy(d *schema.ResourceData, location st
 
 	return &policy, nil
 }
+
+// validateSqlDatabaseSourceDatabaseID checks that the `source_database_id` refers to a resource of
+// the type which the `create_mode` restores from - for example a dropped database is restored from
+// a `restorableDroppedDatabases` resource rather than a `databases` resource
+func validateSqlDatabaseSourceDatabaseID(createMode string, sourceDatabaseID string) error {
+	resourceType := azure.ResourceTypeFromID(sourceDatabaseID)
+
+	var validTypes []string
+	switch strings.ToLower(createMode) {
+	case strings.ToLower(string(sql.Recovery)):
+		validTypes = []string{"Microsoft.Sql/servers/recoverableDatabases"}
+	case strings.ToLower(string(sql.Restore)):
+		validTypes = []string{"Microsoft.Sql/servers/databases", "Microsoft.Sql/servers/restorableDroppedDatabases"}
+	case strings.ToLower(string(sql.RestoreLongTermRetentionBackup)):
+		// Recovery Points can be nested beneath either a Long Term Retention Vault or a Recovery Services Vault
+		if strings.HasSuffix(strings.ToLower(resourceType), "/recoverypoints") {
+			return nil
+		}
+		return fmt.Errorf("`source_database_id` must be the ID of a Recovery Point when `create_mode` is %q but got the ID of a %q resource", createMode, resourceType)
+	default:
+		validTypes = []string{"Microsoft.Sql/servers/databases"}
+	}
+
+	for _, validType := range validTypes {
+		if strings.EqualFold(resourceType, validType) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("`source_database_id` must be the ID of a `%s` resource when `create_mode` is %q but got the ID of a %q resource", strings.Join(validTypes, "` or `"), createMode, resourceType)
+}
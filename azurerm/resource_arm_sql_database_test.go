@@ -2,6 +2,7 @@ package azurerm
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +13,107 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func TestValidateSqlDatabaseSourceDatabaseID(t *testing.T) {
+	serverID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Sql/servers/server1"
+	databaseID := serverID + "/databases/database1"
+	recoverableDatabaseID := serverID + "/recoverableDatabases/database1"
+	restorableDroppedDatabaseID := serverID + "/restorableDroppedDatabases/database1,131403269876900000"
+	longTermRetentionRecoveryPointID := serverID + "/backupLongTermRetentionVaults/RegisteredVault/recoveryPoints/point1"
+	recoveryServicesRecoveryPointID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.RecoveryServices/vaults/vault1/backupFabrics/Azure/protectionContainers/container1/protectedItems/item1/recoveryPoints/point1"
+
+	cases := []struct {
+		CreateMode string
+		ID         string
+		Valid      bool
+	}{
+		{
+			CreateMode: "Copy",
+			ID:         databaseID,
+			Valid:      true,
+		},
+		{
+			CreateMode: "Copy",
+			ID:         recoverableDatabaseID,
+			Valid:      false,
+		},
+		{
+			CreateMode: "OnlineSecondary",
+			ID:         databaseID,
+			Valid:      true,
+		},
+		{
+			CreateMode: "NonReadableSecondary",
+			ID:         databaseID,
+			Valid:      true,
+		},
+		{
+			CreateMode: "PointInTimeRestore",
+			ID:         databaseID,
+			Valid:      true,
+		},
+		{
+			CreateMode: "PointInTimeRestore",
+			ID:         restorableDroppedDatabaseID,
+			Valid:      false,
+		},
+		{
+			CreateMode: "Recovery",
+			ID:         recoverableDatabaseID,
+			Valid:      true,
+		},
+		{
+			CreateMode: "Recovery",
+			ID:         databaseID,
+			Valid:      false,
+		},
+		{
+			CreateMode: "Restore",
+			ID:         restorableDroppedDatabaseID,
+			Valid:      true,
+		},
+		{
+			CreateMode: "Restore",
+			ID:         databaseID,
+			Valid:      true,
+		},
+		{
+			CreateMode: "Restore",
+			ID:         recoverableDatabaseID,
+			Valid:      false,
+		},
+		{
+			CreateMode: "RestoreLongTermRetentionBackup",
+			ID:         longTermRetentionRecoveryPointID,
+			Valid:      true,
+		},
+		{
+			CreateMode: "RestoreLongTermRetentionBackup",
+			ID:         recoveryServicesRecoveryPointID,
+			Valid:      true,
+		},
+		{
+			CreateMode: "RestoreLongTermRetentionBackup",
+			ID:         databaseID,
+			Valid:      false,
+		},
+		{
+			CreateMode: "restore",
+			ID:         strings.ToLower(restorableDroppedDatabaseID),
+			Valid:      true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateSqlDatabaseSourceDatabaseID(tc.CreateMode, tc.ID)
+		if tc.Valid && err != nil {
+			t.Fatalf("Expected %q to be a valid `source_database_id` for the create mode %q but got: %+v", tc.ID, tc.CreateMode, err)
+		}
+		if !tc.Valid && err == nil {
+			t.Fatalf("Expected %q to be an invalid `source_database_id` for the create mode %q", tc.ID, tc.CreateMode)
+		}
+	}
+}
+
 func TestAccAzureRMSqlDatabase_basic(t *testing.T) {
 	resourceName := "azurerm_sql_database.test"
 	ri := tf.AccRandTimeInt()
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/security/mgmt/v1.0/security"
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
@@ -21,6 +22,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/queue/queues"
 )
@@ -113,7 +115,8 @@ func resourceArmStorageAccount() *schema.Resource {
 			"account_encryption_source": {
 				Type:     schema.TypeString,
 				Optional: true,
-				Default:  string(storage.MicrosoftStorage),
+				// Computed since this can be changed to `Microsoft.Keyvault` by the `azurerm_storage_account_customer_managed_key` resource
+				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(storage.MicrosoftKeyvault),
 					string(storage.MicrosoftStorage),
@@ -158,6 +161,23 @@ func resourceArmStorageAccount() *schema.Resource {
 				Optional: true,
 			},
 
+			"azure_files_authentication": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.DirectoryServiceOptionsAADDS),
+							}, false),
+						},
+					},
+				},
+			},
+
 			"is_hns_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -256,6 +276,32 @@ func resourceArmStorageAccount() *schema.Resource {
 				ValidateFunc: validateAzureRMStorageAccountTags,
 			},
 
+			"blob_properties": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_retention_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      7,
+										ValidateFunc: validation.IntBetween(1, 365),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"queue_properties": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -577,6 +623,29 @@ func resourceArmStorageAccount() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+
+			"geo_replication": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"can_failover": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"last_sync_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -605,7 +674,8 @@ func validateAzureRMStorageAccountTags(v interface{}, _ string) (warnings []stri
 }
 
 func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storage.AccountsClient
 	advancedThreatProtectionClient := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
 
@@ -637,6 +707,9 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 	replicationType := d.Get("account_replication_type").(string)
 	storageType := fmt.Sprintf("%s_%s", accountTier, replicationType)
 	storageAccountEncryptionSource := d.Get("account_encryption_source").(string)
+	if storageAccountEncryptionSource == "" {
+		storageAccountEncryptionSource = string(storage.MicrosoftStorage)
+	}
 
 	parameters := storage.AccountCreateParameters{
 		Location: &location,
@@ -662,6 +735,10 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		},
 	}
 
+	if v, ok := d.GetOk("azure_files_authentication"); ok {
+		parameters.AccountPropertiesCreateParameters.AzureFilesIdentityBasedAuthentication = expandStorageAccountAzureFilesAuthentication(v.([]interface{}))
+	}
+
 	if _, ok := d.GetOk("identity"); ok {
 		storageAccountIdentity := expandAzureRmStorageAccountIdentity(d)
 		parameters.Identity = storageAccountIdentity
@@ -737,6 +814,21 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if val, ok := d.GetOk("blob_properties"); ok {
+		// FileStorage accounts don't support the Blob Service
+		if accountKind == string(storage.FileStorage) {
+			return fmt.Errorf("`blob_properties` aren't supported for Storage Accounts of kind %q", accountKind)
+		}
+
+		blobClient := meta.(*ArmClient).storage.BlobServicesClient
+
+		blobProperties := expandBlobProperties(val.([]interface{}))
+
+		if _, err = blobClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, err)
+		}
+	}
+
 	if val, ok := d.GetOk("queue_properties"); ok {
 		queueClient, err := meta.(*ArmClient).storage.QueuesClient(ctx, resourceGroupName, storageAccountName)
 		if err != nil {
@@ -760,7 +852,8 @@ func resourceArmStorageAccountCreate(d *schema.ResourceData, meta interface{}) e
 // and idempotent operation for CreateOrUpdate. In particular updating all of the parameters
 // available requires a call to Update per parameter...
 func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storage.AccountsClient
 	advancedThreatProtectionClient := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
 
@@ -842,6 +935,18 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 			},
 		}
 
+		// when a Customer Managed Key is in use the Key Vault properties must be sent back to the API
+		if strings.EqualFold(encryptionSource, string(storage.MicrosoftKeyvault)) {
+			existing, err := client.GetProperties(ctx, resourceGroupName, storageAccountName, "")
+			if err != nil {
+				return fmt.Errorf("Error retrieving Azure Storage Account %q: %+v", storageAccountName, err)
+			}
+
+			if props := existing.AccountProperties; props != nil && props.Encryption != nil {
+				opts.Encryption.KeyVaultProperties = props.Encryption.KeyVaultProperties
+			}
+		}
+
 		if d.HasChange("enable_blob_encryption") {
 			enableEncryption := d.Get("enable_blob_encryption").(bool)
 			opts.Encryption.Services.Blob = &storage.EncryptionService{
@@ -864,6 +969,20 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("azure_files_authentication") {
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				AzureFilesIdentityBasedAuthentication: expandStorageAccountAzureFilesAuthentication(d.Get("azure_files_authentication").([]interface{})),
+			},
+		}
+
+		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `azure_files_authentication` %q: %+v", storageAccountName, err)
+		}
+
+		d.SetPartial("azure_files_authentication")
+	}
+
 	if d.HasChange("custom_domain") {
 		opts := storage.AccountUpdateParameters{
 			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
@@ -931,6 +1050,23 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("enable_advanced_threat_protection")
 	}
 
+	if d.HasChange("blob_properties") {
+		// FileStorage accounts don't support the Blob Service
+		if accountKind == string(storage.FileStorage) {
+			return fmt.Errorf("`blob_properties` aren't supported for Storage Accounts of kind %q", accountKind)
+		}
+
+		blobClient := meta.(*ArmClient).storage.BlobServicesClient
+
+		blobProperties := expandBlobProperties(d.Get("blob_properties").([]interface{}))
+
+		if _, err = blobClient.SetServiceProperties(ctx, resourceGroupName, storageAccountName, blobProperties); err != nil {
+			return fmt.Errorf("Error updating Azure Storage Account `blob_properties` %q: %+v", storageAccountName, err)
+		}
+
+		d.SetPartial("blob_properties")
+	}
+
 	if d.HasChange("queue_properties") {
 		queueClient, err := meta.(*ArmClient).storage.QueuesClient(ctx, resourceGroupName, storageAccountName)
 		if err != nil {
@@ -954,7 +1090,8 @@ func resourceArmStorageAccountUpdate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	client := meta.(*ArmClient).storage.AccountsClient
 	advancedThreatProtectionClient := meta.(*ArmClient).securityCenter.AdvancedThreatProtectionClient
 	endpointSuffix := meta.(*ArmClient).environment.StorageEndpointSuffix
@@ -1018,6 +1155,10 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("enable_https_traffic_only", props.EnableHTTPSTrafficOnly)
 		d.Set("is_hns_enabled", props.IsHnsEnabled)
 
+		if err := d.Set("azure_files_authentication", flattenStorageAccountAzureFilesAuthentication(props.AzureFilesIdentityBasedAuthentication)); err != nil {
+			return fmt.Errorf("Error setting `azure_files_authentication`: %+v", err)
+		}
+
 		if customDomain := props.CustomDomain; customDomain != nil {
 			if err := d.Set("custom_domain", flattenStorageAccountCustomDomain(customDomain)); err != nil {
 				return fmt.Errorf("Error setting `custom_domain`: %+v", err)
@@ -1112,6 +1253,22 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	// FileStorage accounts don't support the Blob Service
+	if resp.Kind != storage.FileStorage {
+		blobClient := meta.(*ArmClient).storage.BlobServicesClient
+
+		blobProps, err := blobClient.GetServiceProperties(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(blobProps.Response) {
+				return fmt.Errorf("Error reading blob properties for AzureRM Storage Account %q: %+v", name, err)
+			}
+		}
+
+		if err := d.Set("blob_properties", flattenBlobProperties(blobProps)); err != nil {
+			return fmt.Errorf("Error setting `blob_properties `for AzureRM Storage Account %q: %+v", name, err)
+		}
+	}
+
 	queueClient, err := meta.(*ArmClient).storage.QueuesClient(ctx, resGroup, name)
 	if err != nil {
 		return fmt.Errorf("Error building Queues Client: %s", err)
@@ -1128,11 +1285,27 @@ func resourceArmStorageAccountRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("Error setting `queue_properties `for AzureRM Storage Account %q: %+v", name, err)
 	}
 
+	// the Geo Replication Stats can only be expanded for geo-redundant Storage Accounts - and since these
+	// are informational (and not always available, e.g. during a failover) we don't fail the Read for them
+	geoReplication := make([]interface{}, 0)
+	if sku := resp.Sku; sku != nil && storageAccountIsGeoRedundant(sku.Name) {
+		stats, err := client.GetProperties(ctx, resGroup, name, storage.AccountExpandGeoReplicationStats)
+		if err != nil {
+			log.Printf("[WARN] Unable to read the Geo Replication Stats for AzureRM Storage Account %q (Resource Group %q): %+v", name, resGroup, err)
+		} else if props := stats.AccountProperties; props != nil {
+			geoReplication = flattenStorageAccountGeoReplicationStats(props.GeoReplicationStats)
+		}
+	}
+	if err := d.Set("geo_replication", geoReplication); err != nil {
+		return fmt.Errorf("Error setting `geo_replication` for AzureRM Storage Account %q: %+v", name, err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
 func resourceArmStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	storageClient := meta.(*ArmClient).storage
 	client := storageClient.AccountsClient
 
@@ -1218,6 +1391,31 @@ func flattenStorageAccountCustomDomain(input *storage.CustomDomain) []interface{
 	return []interface{}{domain}
 }
 
+func expandStorageAccountAzureFilesAuthentication(input []interface{}) *storage.AzureFilesIdentityBasedAuthentication {
+	if len(input) == 0 || input[0] == nil {
+		return &storage.AzureFilesIdentityBasedAuthentication{
+			DirectoryServiceOptions: storage.DirectoryServiceOptionsNone,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+	return &storage.AzureFilesIdentityBasedAuthentication{
+		DirectoryServiceOptions: storage.DirectoryServiceOptions(v["directory_type"].(string)),
+	}
+}
+
+func flattenStorageAccountAzureFilesAuthentication(input *storage.AzureFilesIdentityBasedAuthentication) []interface{} {
+	if input == nil || input.DirectoryServiceOptions == storage.DirectoryServiceOptionsNone || input.DirectoryServiceOptions == "" {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"directory_type": string(input.DirectoryServiceOptions),
+		},
+	}
+}
+
 func expandStorageAccountNetworkRules(d *schema.ResourceData) *storage.NetworkRuleSet {
 	networkRules := d.Get("network_rules").([]interface{})
 	if len(networkRules) == 0 {
@@ -1282,6 +1480,31 @@ func expandStorageAccountBypass(networkRule map[string]interface{}) storage.Bypa
 	return storage.Bypass(strings.Join(bypassValues, ", "))
 }
 
+func expandBlobProperties(input []interface{}) storage.BlobServiceProperties {
+	properties := storage.BlobServiceProperties{
+		BlobServicePropertiesProperties: &storage.BlobServicePropertiesProperties{
+			DeleteRetentionPolicy: &storage.DeleteRetentionPolicy{
+				Enabled: utils.Bool(false),
+			},
+		},
+	}
+
+	if len(input) == 0 || input[0] == nil {
+		return properties
+	}
+
+	blobAttr := input[0].(map[string]interface{})
+	deletePolicy := blobAttr["delete_retention_policy"].([]interface{})
+	if len(deletePolicy) > 0 && deletePolicy[0] != nil {
+		policy := deletePolicy[0].(map[string]interface{})
+		days := policy["days"].(int)
+		properties.BlobServicePropertiesProperties.DeleteRetentionPolicy.Enabled = utils.Bool(true)
+		properties.BlobServicePropertiesProperties.DeleteRetentionPolicy.Days = utils.Int32(int32(days))
+	}
+
+	return properties
+}
+
 func expandQueueProperties(input []interface{}) (queues.StorageServiceProperties, error) {
 	var err error
 	properties := queues.StorageServiceProperties{}
@@ -1426,6 +1649,32 @@ func flattenStorageAccountVirtualNetworks(input *[]storage.VirtualNetworkRule) [
 	return virtualNetworks
 }
 
+func flattenBlobProperties(input storage.BlobServiceProperties) []interface{} {
+	if input.BlobServicePropertiesProperties == nil {
+		return []interface{}{}
+	}
+
+	deleteRetentionPolicies := make([]interface{}, 0)
+	if deletePolicy := input.BlobServicePropertiesProperties.DeleteRetentionPolicy; deletePolicy != nil {
+		if enabled := deletePolicy.Enabled; enabled != nil && *enabled {
+			days := 0
+			if deletePolicy.Days != nil {
+				days = int(*deletePolicy.Days)
+			}
+
+			deleteRetentionPolicies = append(deleteRetentionPolicies, map[string]interface{}{
+				"days": days,
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"delete_retention_policy": deleteRetentionPolicies,
+		},
+	}
+}
+
 func flattenQueueProperties(input queues.StorageServicePropertiesResponse) []interface{} {
 	if input.Response.Response == nil {
 		return []interface{}{}
@@ -1526,6 +1775,39 @@ func flattenCorsProperty(input string) []interface{} {
 	return results
 }
 
+func storageAccountIsGeoRedundant(input storage.SkuName) bool {
+	switch input {
+	case storage.StandardGRS, storage.StandardGZRS, storage.StandardRAGRS, storage.StandardRAGZRS:
+		return true
+	}
+
+	return false
+}
+
+func flattenStorageAccountGeoReplicationStats(input *storage.GeoReplicationStats) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	canFailover := false
+	if input.CanFailover != nil {
+		canFailover = *input.CanFailover
+	}
+
+	lastSyncTime := ""
+	if input.LastSyncTime != nil {
+		lastSyncTime = input.LastSyncTime.Format(time.RFC3339)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"can_failover":   canFailover,
+			"last_sync_time": lastSyncTime,
+			"status":         string(input.Status),
+		},
+	}
+}
+
 func flattenStorageAccountBypass(input storage.Bypass) []interface{} {
 	bypassValues := strings.Split(string(input), ", ")
 	bypass := make([]interface{}, len(bypassValues))
@@ -0,0 +1,216 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStorageAccountCustomerManagedKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStorageAccountCustomerManagedKeyCreateUpdate,
+		Read:   resourceArmStorageAccountCustomerManagedKeyRead,
+		Update: resourceArmStorageAccountCustomerManagedKeyCreateUpdate,
+		Delete: resourceArmStorageAccountCustomerManagedKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"storage_account_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     azure.ValidateResourceIDOfType("Microsoft.Storage/storageAccounts"),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"key_vault_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     azure.ValidateResourceIDOfType("Microsoft.KeyVault/vaults"),
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"key_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"key_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func resourceArmStorageAccountCustomerManagedKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storage.AccountsClient
+	vaultsClient := meta.(*ArmClient).keyvault.VaultsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	storageAccountId := d.Get("storage_account_id").(string)
+	id, err := azure.ParseAzureResourceID(storageAccountId)
+	if err != nil {
+		return err
+	}
+	name := id.Path["storageAccounts"]
+	resourceGroup := id.ResourceGroup
+
+	account, err := client.GetProperties(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if account.AccountProperties == nil || account.AccountProperties.Encryption == nil {
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): `properties.encryption` was nil", name, resourceGroup)
+	}
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		if account.AccountProperties.Encryption.KeySource == storage.MicrosoftKeyvault {
+			return tf.ImportAsExistsError("azurerm_storage_account_customer_managed_key", storageAccountId)
+		}
+	}
+
+	// the Storage Account's Managed Identity is used to access the Key Vault
+	if account.Identity == nil || account.Identity.PrincipalID == nil {
+		return fmt.Errorf("Error configuring the Customer Managed Key for Storage Account %q (Resource Group %q): a `SystemAssigned` identity must be enabled on the Storage Account", name, resourceGroup)
+	}
+
+	keyVaultId := d.Get("key_vault_id").(string)
+	keyVaultBaseUrl, err := azure.GetKeyVaultBaseUrlFromID(ctx, vaultsClient, keyVaultId)
+	if err != nil {
+		return fmt.Errorf("Error looking up Key Vault URI from ID %q: %+v", keyVaultId, err)
+	}
+
+	props := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: &storage.Encryption{
+				Services:  account.AccountProperties.Encryption.Services,
+				KeySource: storage.MicrosoftKeyvault,
+				KeyVaultProperties: &storage.KeyVaultProperties{
+					KeyName:     utils.String(d.Get("key_name").(string)),
+					KeyVersion:  utils.String(d.Get("key_version").(string)),
+					KeyVaultURI: utils.String(keyVaultBaseUrl),
+				},
+			},
+		},
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, name, props); err != nil {
+		return fmt.Errorf("Error configuring the Customer Managed Key for Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.SetId(storageAccountId)
+
+	return resourceArmStorageAccountCustomerManagedKeyRead(d, meta)
+}
+
+func resourceArmStorageAccountCustomerManagedKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storage.AccountsClient
+	vaultsClient := meta.(*ArmClient).keyvault.VaultsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["storageAccounts"]
+	resourceGroup := id.ResourceGroup
+
+	account, err := client.GetProperties(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			log.Printf("[DEBUG] Storage Account %q (Resource Group %q) was not found - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	props := account.AccountProperties
+	if props == nil || props.Encryption == nil || props.Encryption.KeySource != storage.MicrosoftKeyvault {
+		log.Printf("[DEBUG] Customer Managed Key was not configured for Storage Account %q (Resource Group %q) - removing from state", name, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_account_id", d.Id())
+
+	if kv := props.Encryption.KeyVaultProperties; kv != nil {
+		d.Set("key_name", kv.KeyName)
+		d.Set("key_version", kv.KeyVersion)
+
+		if kv.KeyVaultURI != nil {
+			keyVaultId, err := azure.GetKeyVaultIDFromBaseUrl(ctx, vaultsClient, *kv.KeyVaultURI)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Key Vault ID from URI %q: %+v", *kv.KeyVaultURI, err)
+			}
+
+			// the Key Vault may live in another Subscription, in which case we keep the value from the config
+			if keyVaultId != nil {
+				d.Set("key_vault_id", keyVaultId)
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceArmStorageAccountCustomerManagedKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).storage.AccountsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	name := id.Path["storageAccounts"]
+	resourceGroup := id.ResourceGroup
+
+	account, err := client.GetProperties(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(account.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	var services *storage.EncryptionServices
+	if props := account.AccountProperties; props != nil && props.Encryption != nil {
+		services = props.Encryption.Services
+	}
+
+	// the Customer Managed Key can't be removed, instead we revert to using a Microsoft Managed Key
+	props := storage.AccountUpdateParameters{
+		AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+			Encryption: &storage.Encryption{
+				Services:  services,
+				KeySource: storage.MicrosoftStorage,
+			},
+		},
+	}
+
+	if _, err := client.Update(ctx, resourceGroup, name, props); err != nil {
+		return fmt.Errorf("Error removing the Customer Managed Key from Storage Account %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
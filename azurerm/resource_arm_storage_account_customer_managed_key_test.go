@@ -0,0 +1,213 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2019-04-01/storage"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestAccAzureRMStorageAccountCustomerManagedKey_basic(t *testing.T) {
+	resourceName := "azurerm_storage_account_customer_managed_key.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountCustomerManagedKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "key_version"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStorageAccountCustomerManagedKey_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_storage_account_customer_managed_key.test"
+	ri := tf.AccRandTimeInt()
+	rs := acctest.RandString(4)
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStorageAccountCustomerManagedKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStorageAccountCustomerManagedKey_basic(ri, rs, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStorageAccountCustomerManagedKey_requiresImport(ri, rs, location),
+				ExpectError: testRequiresImportError("azurerm_storage_account_customer_managed_key"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStorageAccountCustomerManagedKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).storage.AccountsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		name := id.Path["storageAccounts"]
+		resourceGroup := id.ResourceGroup
+
+		resp, err := client.GetProperties(ctx, resourceGroup, name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on storageServiceClient: %+v", err)
+		}
+
+		if props := resp.AccountProperties; props == nil || props.Encryption == nil || props.Encryption.KeySource != storage.MicrosoftKeyvault {
+			return fmt.Errorf("Bad: Storage Account %q (Resource Group %q) is not using a Customer Managed Key", name, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStorageAccountCustomerManagedKeyDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).storage.AccountsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_storage_account_customer_managed_key" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		name := id.Path["storageAccounts"]
+		resourceGroup := id.ResourceGroup
+
+		resp, err := client.GetProperties(ctx, resourceGroup, name, "")
+		if err != nil {
+			// the Storage Account is deleted alongside the Customer Managed Key
+			return nil
+		}
+
+		if props := resp.AccountProperties; props != nil && props.Encryption != nil && props.Encryption.KeySource == storage.MicrosoftKeyvault {
+			return fmt.Errorf("Storage Account %q (Resource Group %q) is still using a Customer Managed Key", name, resourceGroup)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_basic(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                     = "acctestkv%s"
+  location                 = "${azurerm_resource_group.test.location}"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  tenant_id                = "${data.azurerm_client_config.current.tenant_id}"
+  sku_name                 = "standard"
+  soft_delete_enabled      = true
+  purge_protection_enabled = true
+}
+
+resource "azurerm_key_vault_access_policy" "storage" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${data.azurerm_client_config.current.tenant_id}"
+  object_id    = "${azurerm_storage_account.test.identity.0.principal_id}"
+
+  key_permissions    = ["get", "unwrapKey", "wrapKey"]
+  secret_permissions = ["get"]
+}
+
+resource "azurerm_key_vault_access_policy" "client" {
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  tenant_id    = "${data.azurerm_client_config.current.tenant_id}"
+  object_id    = "${data.azurerm_client_config.current.object_id}"
+
+  key_permissions    = ["get", "create", "delete", "list", "restore", "recover", "unwrapKey", "wrapKey", "purge", "encrypt", "decrypt", "sign", "verify"]
+  secret_permissions = ["get"]
+}
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "first"
+  key_vault_id = "${azurerm_key_vault.test.id}"
+  key_type     = "RSA"
+  key_size     = 2048
+  key_opts     = ["decrypt", "encrypt", "sign", "unwrapKey", "verify", "wrapKey"]
+
+  depends_on = ["azurerm_key_vault_access_policy.client", "azurerm_key_vault_access_policy.storage"]
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_storage_account_customer_managed_key" "test" {
+  storage_account_id = "${azurerm_storage_account.test.id}"
+  key_vault_id       = "${azurerm_key_vault.test.id}"
+  key_name           = "${azurerm_key_vault_key.test.name}"
+  key_version        = "${azurerm_key_vault_key.test.version}"
+}
+`, rInt, location, rString, rString)
+}
+
+func testAccAzureRMStorageAccountCustomerManagedKey_requiresImport(rInt int, rString string, location string) string {
+	template := testAccAzureRMStorageAccountCustomerManagedKey_basic(rInt, rString, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_customer_managed_key" "import" {
+  storage_account_id = "${azurerm_storage_account_customer_managed_key.test.storage_account_id}"
+  key_vault_id       = "${azurerm_storage_account_customer_managed_key.test.key_vault_id}"
+  key_name           = "${azurerm_storage_account_customer_managed_key.test.key_name}"
+  key_version        = "${azurerm_storage_account_customer_managed_key.test.key_version}"
+}
+`, template)
+}
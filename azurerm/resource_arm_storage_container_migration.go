@@ -4,36 +4,49 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
-func resourceStorageContainerMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	switch v {
-	case 0:
-		log.Println("[INFO] Found AzureRM Storage Container State v0; migrating to v1")
-		return migrateStorageContainerStateV0toV1(is, meta)
-	default:
-		return is, fmt.Errorf("Unexpected schema version: %d", v)
+func resourceStorageContainerStateResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageContainerName,
+			},
+			"resource_group_name": azure.SchemaResourceGroupName(),
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"container_access_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: validation.StringInSlice([]string{"blob", "container", "private"}, false),
+			},
+			"properties": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+		},
 	}
 }
 
-func migrateStorageContainerStateV0toV1(is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	if is.Empty() {
-		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
-		return is, nil
-	}
-
-	log.Printf("[DEBUG] ARM Storage Container Attributes before Migration: %#v", is.Attributes)
-
+func resourceStorageContainerStateUpgradeV0ToV1(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	containerName := rawState["name"].(string)
+	accountName := rawState["storage_account_name"].(string)
 	environment := meta.(*ArmClient).environment
 
-	containerName := is.Attributes["name"]
-	storageAccountName := is.Attributes["storage_account_name"]
-	newID := fmt.Sprintf("https://%s.blob.%s/%s", storageAccountName, environment.StorageEndpointSuffix, containerName)
-	is.Attributes["id"] = newID
-	is.ID = newID
-
-	log.Printf("[DEBUG] ARM Storage Container Attributes after State Migration: %#v", is.Attributes)
+	id := rawState["id"].(string)
+	newResourceID := fmt.Sprintf("https://%s.blob.%s/%s", accountName, environment.StorageEndpointSuffix, containerName)
+	log.Printf("[DEBUG] Updating ID from %q to %q", id, newResourceID)
 
-	return is, nil
+	rawState["id"] = newResourceID
+	return rawState, nil
 }
@@ -2,65 +2,48 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
-	"github.com/hashicorp/terraform/terraform"
+	"github.com/Azure/go-autorest/autorest/azure"
 )
 
-// NOTE: this is intentionally an acceptance test (and we're not explicitly setting the env)
-// as we want to run this depending on the cloud we're in.
-func TestAccAzureRMStorageContainerMigrateState(t *testing.T) {
-	config := testGetAzureConfig(t)
-	if config == nil {
-		t.SkipNow()
-		return
+func TestAzureRMStorageContainerMigrateStateV0ToV1(t *testing.T) {
+	clouds := []azure.Environment{
+		azure.ChinaCloud,
+		azure.GermanCloud,
+		azure.PublicCloud,
+		azure.USGovernmentCloud,
 	}
 
-	client, err := getArmClient(config, false, "", true)
-	if err != nil {
-		t.Fatal(fmt.Errorf("Error building ARM Client: %+v", err))
-		return
-	}
-
-	client.StopContext = testAccProvider.StopContext()
-
-	suffix := client.environment.StorageEndpointSuffix
-
-	cases := map[string]struct {
-		StateVersion       int
-		ID                 string
-		InputAttributes    map[string]string
-		ExpectedAttributes map[string]string
-	}{
-		"v0_1_without_value": {
-			StateVersion: 0,
-			ID:           "some_id",
-			InputAttributes: map[string]string{
-				"name":                 "container",
-				"storage_account_name": "example",
-			},
-			ExpectedAttributes: map[string]string{
-				"id": fmt.Sprintf("https://example.blob.%s/container", suffix),
-			},
-		},
-	}
+	for _, cloud := range clouds {
+		t.Logf("[DEBUG] Testing with Cloud %q", cloud.Name)
 
-	for tn, tc := range cases {
-		is := &terraform.InstanceState{
-			ID:         tc.ID,
-			Attributes: tc.InputAttributes,
+		input := map[string]interface{}{
+			"id":                   "some_id",
+			"name":                 "container1",
+			"resource_group_name":  "group1",
+			"storage_account_name": "account1",
+		}
+		meta := &ArmClient{
+			environment: cloud,
+		}
+		expected := map[string]interface{}{
+			"id":                   fmt.Sprintf("https://account1.blob.%s/container1", cloud.StorageEndpointSuffix),
+			"name":                 "container1",
+			"resource_group_name":  "group1",
+			"storage_account_name": "account1",
 		}
-		is, err := resourceStorageContainerMigrateState(tc.StateVersion, is, client)
 
+		actual, err := resourceStorageContainerStateUpgradeV0ToV1(input, meta)
 		if err != nil {
-			t.Fatalf("bad: %s, err: %#v", tn, err)
+			t.Fatalf("Expected no error but got: %s", err)
 		}
 
-		for k, v := range tc.ExpectedAttributes {
-			actual := is.Attributes[k]
-			if actual != v {
-				t.Fatalf("Bad Storage Container Migrate for %q: %q\n\n expected: %q", k, actual, v)
-			}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Fatalf("Expected %+v. Got %+v. But expected them to be the same", expected, actual)
 		}
+
+		t.Logf("[DEBUG] Ok!")
 	}
 }
@@ -4,37 +4,40 @@ import (
 	"fmt"
 	"log"
 
-	"github.com/hashicorp/terraform/terraform"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
 )
 
-func resourceStorageQueueMigrateState(
-	v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	switch v {
-	case 0:
-		log.Println("[INFO] Found AzureRM Storage Queue State v0; migrating to v1")
-		return migrateStorageQueueStateV0toV1(is, meta)
-	default:
-		return is, fmt.Errorf("Unexpected schema version: %d", v)
+func resourceStorageQueueStateResourceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateArmStorageQueueName,
+			},
+			"resource_group_name": azure.SchemaResourceGroupName(),
+			"storage_account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"metadata": storage.MetaDataSchema(),
+		},
 	}
 }
 
-func migrateStorageQueueStateV0toV1(is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
-	if is.Empty() {
-		log.Println("[DEBUG] Empty InstanceState; nothing to migrate.")
-		return is, nil
-	}
-
-	log.Printf("[DEBUG] ARM Storage Queue Attributes before Migration: %#v", is.Attributes)
-
+func resourceStorageQueueStateUpgradeV0ToV1(rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	queueName := rawState["name"].(string)
+	accountName := rawState["storage_account_name"].(string)
 	environment := meta.(*ArmClient).environment
 
-	queueName := is.Attributes["name"]
-	storageAccountName := is.Attributes["storage_account_name"]
-	newID := fmt.Sprintf("https://%s.queue.%s/%s", storageAccountName, environment.StorageEndpointSuffix, queueName)
-	is.Attributes["id"] = newID
-	is.ID = newID
-
-	log.Printf("[DEBUG] ARM Storage Queue Attributes after State Migration: %#v", is.Attributes)
+	id := rawState["id"].(string)
+	newResourceID := fmt.Sprintf("https://%s.queue.%s/%s", accountName, environment.StorageEndpointSuffix, queueName)
+	log.Printf("[DEBUG] Updating ID from %q to %q", id, newResourceID)
 
-	return is, nil
+	rawState["id"] = newResourceID
+	return rawState, nil
 }
@@ -0,0 +1,220 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/streamanalytics/mgmt/2016-03-01/streamanalytics"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmStreamAnalyticsOutputCosmosDB() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmStreamAnalyticsOutputCosmosDBCreateUpdate,
+		Read:   resourceArmStreamAnalyticsOutputCosmosDBRead,
+		Update: resourceArmStreamAnalyticsOutputCosmosDBCreateUpdate,
+		Delete: resourceArmStreamAnalyticsOutputCosmosDBDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"stream_analytics_job_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"cosmosdb_account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"cosmosdb_account_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"database": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"collection_name_pattern": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"partition_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+
+			"document_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.NoEmptyStrings,
+			},
+		},
+	}
+}
+
+func resourceArmStreamAnalyticsOutputCosmosDBCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamanalytics.OutputsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Azure Stream Analytics Output CosmosDB creation.")
+	name := d.Get("name").(string)
+	jobName := d.Get("stream_analytics_job_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if features.ShouldResourcesBeImported() && d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for presence of existing Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %s", name, jobName, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_stream_analytics_output_cosmosdb", *existing.ID)
+		}
+	}
+
+	dataSourceProps := &streamanalytics.DocumentDbOutputDataSourceProperties{
+		AccountID:             utils.String(d.Get("cosmosdb_account_name").(string)),
+		AccountKey:            utils.String(d.Get("cosmosdb_account_key").(string)),
+		Database:              utils.String(d.Get("database").(string)),
+		CollectionNamePattern: utils.String(d.Get("collection_name_pattern").(string)),
+	}
+
+	if v, ok := d.GetOk("partition_key"); ok {
+		dataSourceProps.PartitionKey = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("document_id"); ok {
+		dataSourceProps.DocumentID = utils.String(v.(string))
+	}
+
+	props := streamanalytics.Output{
+		Name: utils.String(name),
+		OutputProperties: &streamanalytics.OutputProperties{
+			Datasource: &streamanalytics.DocumentDbOutputDataSource{
+				Type:                                 streamanalytics.TypeMicrosoftStorageDocumentDB,
+				DocumentDbOutputDataSourceProperties: dataSourceProps,
+			},
+		},
+	}
+
+	if d.IsNewResource() {
+		if _, err := client.CreateOrReplace(ctx, props, resourceGroup, jobName, name, "", ""); err != nil {
+			return fmt.Errorf("Error Creating Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+
+		read, err := client.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+		if read.ID == nil {
+			return fmt.Errorf("Cannot read ID of Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q)", name, jobName, resourceGroup)
+		}
+
+		d.SetId(*read.ID)
+	} else {
+		if _, err := client.Update(ctx, props, resourceGroup, jobName, name, ""); err != nil {
+			return fmt.Errorf("Error Updating Stream Analytics Output CosmosDB %q (Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return resourceArmStreamAnalyticsOutputCosmosDBRead(d, meta)
+}
+
+func resourceArmStreamAnalyticsOutputCosmosDBRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamanalytics.OutputsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	resp, err := client.Get(ctx, resourceGroup, jobName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Output CosmosDB %q was not found in Stream Analytics Job %q / Resource Group %q - removing from state!", name, jobName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Stream Output CosmosDB %q (Stream Analytics Job %q / Resource Group %q): %+v", name, jobName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("stream_analytics_job_name", jobName)
+
+	if props := resp.OutputProperties; props != nil {
+		v, ok := props.Datasource.AsDocumentDbOutputDataSource()
+		if !ok {
+			return fmt.Errorf("Error converting Output Data Source to a CosmosDB Output: %+v", err)
+		}
+
+		d.Set("cosmosdb_account_name", v.AccountID)
+		d.Set("database", v.Database)
+		d.Set("collection_name_pattern", v.CollectionNamePattern)
+		d.Set("partition_key", v.PartitionKey)
+		d.Set("document_id", v.DocumentID)
+	}
+
+	return nil
+}
+
+func resourceArmStreamAnalyticsOutputCosmosDBDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).streamanalytics.OutputsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	jobName := id.Path["streamingjobs"]
+	name := id.Path["outputs"]
+
+	if resp, err := client.Delete(ctx, resourceGroup, jobName, name); err != nil {
+		if !response.WasNotFound(resp.Response) {
+			return fmt.Errorf("Error deleting Output CosmosDB %q (Stream Analytics Job %q / Resource Group %q) %+v", name, jobName, resourceGroup, err)
+		}
+	}
+
+	return nil
+}
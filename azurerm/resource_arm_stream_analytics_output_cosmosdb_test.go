@@ -0,0 +1,238 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestAccAzureRMStreamAnalyticsOutputCosmosDB_basic(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_cosmosdb.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputCosmosDBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputCosmosDB_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputCosmosDBExists(resourceName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"cosmosdb_account_key",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputCosmosDB_update(t *testing.T) {
+	resourceName := "azurerm_stream_analytics_output_cosmosdb.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputCosmosDBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputCosmosDB_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputCosmosDBExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputCosmosDB_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputCosmosDBExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "partition_key", "deviceId"),
+					resource.TestCheckResourceAttr(resourceName, "document_id", "eventId"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					// not returned from the API
+					"cosmosdb_account_key",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMStreamAnalyticsOutputCosmosDB_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_stream_analytics_output_cosmosdb.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMStreamAnalyticsOutputCosmosDBDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMStreamAnalyticsOutputCosmosDB_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMStreamAnalyticsOutputCosmosDBExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMStreamAnalyticsOutputCosmosDB_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_stream_analytics_output_cosmosdb"),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMStreamAnalyticsOutputCosmosDBExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		conn := testAccProvider.Meta().(*ArmClient).streamanalytics.OutputsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on streamAnalyticsOutputsClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Stream Output %q (Stream Analytics Job %q / Resource Group %q) does not exist", name, jobName, resourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMStreamAnalyticsOutputCosmosDBDestroy(s *terraform.State) error {
+	conn := testAccProvider.Meta().(*ArmClient).streamanalytics.OutputsClient
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_stream_analytics_output_cosmosdb" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		jobName := rs.Primary.Attributes["stream_analytics_job_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := conn.Get(ctx, resourceGroup, jobName, name)
+		if err != nil {
+			return nil
+		}
+
+		if resp.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("Stream Analytics Output CosmosDB still exists:\n%#v", resp.OutputProperties)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMStreamAnalyticsOutputCosmosDB_basic(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputCosmosDB_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_cosmosdb" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${azurerm_stream_analytics_job.test.name}"
+  resource_group_name       = "${azurerm_stream_analytics_job.test.resource_group_name}"
+  cosmosdb_account_name     = "${azurerm_cosmosdb_account.test.name}"
+  cosmosdb_account_key      = "${azurerm_cosmosdb_account.test.primary_master_key}"
+  database                  = "${azurerm_cosmosdb_sql_database.test.name}"
+  collection_name_pattern   = "acctest"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputCosmosDB_updated(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputCosmosDB_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_cosmosdb" "test" {
+  name                      = "acctestoutput-%d"
+  stream_analytics_job_name = "${azurerm_stream_analytics_job.test.name}"
+  resource_group_name       = "${azurerm_stream_analytics_job.test.resource_group_name}"
+  cosmosdb_account_name     = "${azurerm_cosmosdb_account.test.name}"
+  cosmosdb_account_key      = "${azurerm_cosmosdb_account.test.primary_master_key}"
+  database                  = "${azurerm_cosmosdb_sql_database.test.name}"
+  collection_name_pattern   = "acctest-{partition}"
+  partition_key             = "deviceId"
+  document_id               = "eventId"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMStreamAnalyticsOutputCosmosDB_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMStreamAnalyticsOutputCosmosDB_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_output_cosmosdb" "import" {
+  name                      = "${azurerm_stream_analytics_output_cosmosdb.test.name}"
+  stream_analytics_job_name = "${azurerm_stream_analytics_output_cosmosdb.test.stream_analytics_job_name}"
+  resource_group_name       = "${azurerm_stream_analytics_output_cosmosdb.test.resource_group_name}"
+  cosmosdb_account_name     = "${azurerm_stream_analytics_output_cosmosdb.test.cosmosdb_account_name}"
+  cosmosdb_account_key      = "${azurerm_stream_analytics_output_cosmosdb.test.cosmosdb_account_key}"
+  database                  = "${azurerm_stream_analytics_output_cosmosdb.test.database}"
+  collection_name_pattern   = "${azurerm_stream_analytics_output_cosmosdb.test.collection_name_pattern}"
+}
+`, template)
+}
+
+func testAccAzureRMStreamAnalyticsOutputCosmosDB_template(rInt int, location string) string {
+	template := testAccAzureRMCosmosDbSqlDatabase_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_stream_analytics_job" "test" {
+  name                                     = "acctestjob-%d"
+  resource_group_name                      = "${azurerm_resource_group.test.name}"
+  location                                 = "${azurerm_resource_group.test.location}"
+  compatibility_level                      = "1.0"
+  data_locale                              = "en-GB"
+  events_late_arrival_max_delay_in_seconds = 60
+  events_out_of_order_max_delay_in_seconds = 50
+  events_out_of_order_policy               = "Adjust"
+  output_error_policy                      = "Drop"
+  streaming_units                          = 3
+
+  transformation_query = <<QUERY
+    SELECT *
+    INTO [YourOutputAlias]
+    FROM [YourInputAlias]
+QUERY
+}
+`, template, rInt)
+}
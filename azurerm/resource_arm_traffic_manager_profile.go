@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"log"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/trafficmanager/mgmt/2018-04-01/trafficmanager"
@@ -13,8 +15,10 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -61,6 +65,12 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 				}, false),
 			},
 
+			"max_return": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 8),
+			},
+
 			"dns_config": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -129,6 +139,35 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 							ValidateFunc: validation.IntBetween(0, 9),
 							Default:      3,
 						},
+						"expected_status_code_ranges": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringMatch(
+									regexp.MustCompile(`^[1-9][0-9]{2}-[1-9][0-9]{2}$`),
+									"`expected_status_code_ranges` must be in the format `min-max`, e.g. `200-299`",
+								),
+							},
+						},
+						"custom_header": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+									"value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validate.NoEmptyStrings,
+									},
+								},
+							},
+						},
 					},
 				},
 				Set: resourceAzureRMTrafficManagerMonitorConfigHash,
@@ -141,7 +180,8 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 
 func resourceArmTrafficManagerProfileCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).trafficManager.ProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for TrafficManager Profile creation.")
 
@@ -196,7 +236,8 @@ func resourceArmTrafficManagerProfileCreateUpdate(d *schema.ResourceData, meta i
 
 func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).trafficManager.ProfilesClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -221,6 +262,7 @@ func resourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interface
 	d.Set("name", resp.Name)
 	d.Set("profile_status", profile.ProfileStatus)
 	d.Set("traffic_routing_method", profile.TrafficRoutingMethod)
+	d.Set("max_return", profile.MaxReturn)
 
 	dnsFlat := flattenAzureRMTrafficManagerProfileDNSConfig(profile.DNSConfig)
 	d.Set("dns_config", schema.NewSet(resourceAzureRMTrafficManagerDNSConfigHash, dnsFlat))
@@ -244,7 +286,8 @@ func resourceArmTrafficManagerProfileDelete(d *schema.ResourceData, meta interfa
 	resGroup := id.ResourceGroup
 	name := id.Path["trafficManagerProfiles"]
 
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 	resp, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp.Response) {
@@ -273,6 +316,16 @@ func getArmTrafficManagerProfileProperties(d *schema.ResourceData) (*trafficmana
 		props.ProfileStatus = trafficmanager.ProfileStatus(s)
 	}
 
+	maxReturn := d.Get("max_return").(int)
+	if routingMethod == string(trafficmanager.MultiValue) {
+		if maxReturn == 0 {
+			return nil, fmt.Errorf("`max_return` must be specified when `traffic_routing_method` is set to `MultiValue`")
+		}
+		props.MaxReturn = utils.Int64(int64(maxReturn))
+	} else if maxReturn != 0 {
+		return nil, fmt.Errorf("`max_return` can only be specified when `traffic_routing_method` is set to `MultiValue`")
+	}
+
 	return props, nil
 }
 
@@ -291,14 +344,52 @@ func expandArmTrafficManagerMonitorConfig(d *schema.ResourceData) (*trafficmanag
 		return nil, fmt.Errorf("`timeout_in_seconds` must be between `5` and `9` when `interval_in_seconds` is set to `10`")
 	}
 
-	return &trafficmanager.MonitorConfig{
+	cfg := trafficmanager.MonitorConfig{
 		Protocol:                  trafficmanager.MonitorProtocol(proto),
 		Port:                      &port,
 		Path:                      &path,
 		IntervalInSeconds:         &interval,
 		TimeoutInSeconds:          &timeout,
 		ToleratedNumberOfFailures: &tolerated,
-	}, nil
+	}
+
+	statusCodeRanges := make([]trafficmanager.MonitorConfigExpectedStatusCodeRangesItem, 0)
+	for _, v := range monitor["expected_status_code_ranges"].([]interface{}) {
+		parts := strings.Split(v.(string), "-")
+		min, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing the minimum of `expected_status_code_ranges` %q: %+v", v, err)
+		}
+		max, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing the maximum of `expected_status_code_ranges` %q: %+v", v, err)
+		}
+		if min > max {
+			return nil, fmt.Errorf("The minimum of `expected_status_code_ranges` %q must not be greater than the maximum", v)
+		}
+
+		statusCodeRanges = append(statusCodeRanges, trafficmanager.MonitorConfigExpectedStatusCodeRangesItem{
+			Min: utils.Int32(int32(min)),
+			Max: utils.Int32(int32(max)),
+		})
+	}
+	if len(statusCodeRanges) > 0 {
+		cfg.ExpectedStatusCodeRanges = &statusCodeRanges
+	}
+
+	headers := make([]trafficmanager.MonitorConfigCustomHeadersItem, 0)
+	for _, v := range monitor["custom_header"].([]interface{}) {
+		header := v.(map[string]interface{})
+		headers = append(headers, trafficmanager.MonitorConfigCustomHeadersItem{
+			Name:  utils.String(header["name"].(string)),
+			Value: utils.String(header["value"].(string)),
+		})
+	}
+	if len(headers) > 0 {
+		cfg.CustomHeaders = &headers
+	}
+
+	return &cfg, nil
 }
 
 func expandArmTrafficManagerDNSConfig(d *schema.ResourceData) *trafficmanager.DNSConfig {
@@ -337,6 +428,32 @@ func flattenAzureRMTrafficManagerProfileMonitorConfig(cfg *trafficmanager.Monito
 	result["timeout_in_seconds"] = int(*cfg.TimeoutInSeconds)
 	result["tolerated_number_of_failures"] = int(*cfg.ToleratedNumberOfFailures)
 
+	statusCodeRanges := make([]interface{}, 0)
+	if cfg.ExpectedStatusCodeRanges != nil {
+		for _, r := range *cfg.ExpectedStatusCodeRanges {
+			if r.Min == nil || r.Max == nil {
+				continue
+			}
+			statusCodeRanges = append(statusCodeRanges, fmt.Sprintf("%d-%d", *r.Min, *r.Max))
+		}
+	}
+	result["expected_status_code_ranges"] = statusCodeRanges
+
+	headers := make([]interface{}, 0)
+	if cfg.CustomHeaders != nil {
+		for _, h := range *cfg.CustomHeaders {
+			header := make(map[string]interface{})
+			if h.Name != nil {
+				header["name"] = *h.Name
+			}
+			if h.Value != nil {
+				header["value"] = *h.Value
+			}
+			headers = append(headers, header)
+		}
+	}
+	result["custom_header"] = headers
+
 	return []interface{}{result}
 }
 
@@ -373,6 +490,20 @@ func resourceAzureRMTrafficManagerMonitorConfigHash(v interface{}) int {
 		if v, ok := m["tolerated_number_of_failures"]; ok && v != "" {
 			buf.WriteString(fmt.Sprintf("%d-", m["tolerated_number_of_failures"].(int)))
 		}
+
+		if v, ok := m["expected_status_code_ranges"].([]interface{}); ok {
+			for _, r := range v {
+				buf.WriteString(fmt.Sprintf("%s-", r.(string)))
+			}
+		}
+
+		if v, ok := m["custom_header"].([]interface{}); ok {
+			for _, h := range v {
+				if header, ok := h.(map[string]interface{}); ok {
+					buf.WriteString(fmt.Sprintf("%s:%s-", header["name"].(string), header["value"].(string)))
+				}
+			}
+		}
 	}
 
 	return hashcode.String(buf.String())
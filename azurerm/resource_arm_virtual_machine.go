@@ -21,6 +21,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	intStor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"github.com/tombuildsstuff/giovanni/storage/2018-11-09/blob/blobs"
 	"golang.org/x/net/context"
@@ -101,6 +102,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 					return strings.ToLower(id.(string))
 				},
 				ConflictsWith: []string{"zones"},
+				ValidateFunc:  azure.ValidateResourceIDOfTypeOrEmpty("Microsoft.Compute/availabilitySets"),
 			},
 
 			"proximity_placement_group_id": {
@@ -113,6 +115,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 				//
 				// todo can be removed when https://github.com/Azure/azure-sdk-for-go/issues/5699 is fixed
 				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc:     azure.ValidateResourceIDOfTypeOrEmpty("Microsoft.Compute/proximityPlacementGroups"),
 			},
 
 			"identity": {
@@ -242,11 +245,13 @@ func resourceArmVirtualMachine() *schema.Resource {
 						},
 
 						"managed_disk_id": {
-							Type:          schema.TypeString,
-							Optional:      true,
-							ForceNew:      true,
-							Computed:      true,
-							ConflictsWith: []string{"storage_os_disk.0.vhd_uri"},
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Computed:         true,
+							ConflictsWith:    []string{"storage_os_disk.0.vhd_uri"},
+							ValidateFunc:     azure.ValidateResourceIDOfTypeOrEmpty("Microsoft.Compute/disks"),
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
 						"managed_disk_type": {
@@ -290,6 +295,25 @@ func resourceArmVirtualMachine() *schema.Resource {
 							Optional: true,
 							Default:  false,
 						},
+
+						"diff_disk_settings": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"option": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											string(compute.Local),
+										}, false),
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -321,6 +345,7 @@ func resourceArmVirtualMachine() *schema.Resource {
 							Optional:         true,
 							Computed:         true,
 							DiffSuppressFunc: suppress.CaseDifference,
+							ValidateFunc:     azure.ValidateResourceIDOfTypeOrEmpty("Microsoft.Compute/disks"),
 						},
 
 						"managed_disk_type": {
@@ -567,8 +592,10 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"source_vault_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateFunc:     azure.ValidateResourceIDOfType("Microsoft.KeyVault/vaults"),
+							DiffSuppressFunc: suppress.CaseDifference,
 						},
 
 						"vault_certificates": {
@@ -600,8 +627,10 @@ func resourceArmVirtualMachine() *schema.Resource {
 			},
 
 			"primary_network_interface_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceIDOfTypeOrEmpty("Microsoft.Network/networkInterfaces"),
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"tags": tags.Schema(),
@@ -611,7 +640,8 @@ func resourceArmVirtualMachine() *schema.Resource {
 
 func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).compute.VMClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine creation.")
 
@@ -775,7 +805,8 @@ func resourceArmVirtualMachineCreateUpdate(d *schema.ResourceData, meta interfac
 
 func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
 	vmclient := meta.(*ArmClient).compute.VMClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -904,7 +935,8 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 
 func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).compute.VMClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -1371,6 +1403,8 @@ func flattenAzureRmVirtualMachineOsDisk(disk *compute.OSDisk, diskInfo *compute.
 		result["write_accelerator_enabled"] = *disk.WriteAcceleratorEnabled
 	}
 
+	result["diff_disk_settings"] = flattenAzureRmVirtualMachineDiffDiskSettings(disk.DiffDiskSettings)
+
 	flattenAzureRmVirtualMachineReviseDiskInfo(result, diskInfo)
 
 	return []interface{}{result}
@@ -1854,9 +1888,40 @@ func expandAzureRmVirtualMachineOsDisk(d *schema.ResourceData) (*compute.OSDisk,
 		osDisk.WriteAcceleratorEnabled = utils.Bool(v)
 	}
 
+	if v, ok := config["diff_disk_settings"].([]interface{}); ok && len(v) > 0 {
+		if !strings.EqualFold(string(osDisk.Caching), string(compute.CachingTypesReadOnly)) {
+			return nil, fmt.Errorf("[ERROR] `caching` must be set to `ReadOnly` when using `diff_disk_settings` on `storage_os_disk`")
+		}
+
+		osDisk.DiffDiskSettings = expandAzureRmVirtualMachineDiffDiskSettings(v)
+	}
+
 	return osDisk, nil
 }
 
+func expandAzureRmVirtualMachineDiffDiskSettings(input []interface{}) *compute.DiffDiskSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &compute.DiffDiskSettings{
+		Option: compute.DiffDiskOptions(v["option"].(string)),
+	}
+}
+
+func flattenAzureRmVirtualMachineDiffDiskSettings(input *compute.DiffDiskSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"option": string(input.Option),
+		},
+	}
+}
+
 func resourceArmVirtualMachineStorageOsProfileHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -0,0 +1,328 @@
+package azurerm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "settings", regexp.MustCompile("hostname")),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_requiresImport(t *testing.T) {
+	if !features.ShouldResourcesBeImported() {
+		t.Skip("Skipping since resources aren't required to be imported")
+		return
+	}
+
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMVirtualMachineScaleSetExtension_requiresImport(ri, location),
+				ExpectError: testRequiresImportError("azurerm_virtual_machine_scale_set_extension"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_update(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := tf.AccRandTimeInt()
+	location := testLocation()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "settings", regexp.MustCompile("whoami")),
+					resource.TestCheckResourceAttr(resourceName, "auto_upgrade_minor_version", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"protected_settings"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).compute.VMScaleSetExtensionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_scale_set_extension" {
+			continue
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		scaleSetName := id.Path["virtualMachineScaleSets"]
+		name := id.Path["extensions"]
+
+		resp, err := client.Get(ctx, resourceGroup, scaleSetName, name, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return err
+			}
+			return nil
+		}
+
+		return fmt.Errorf("Extension %q (Virtual Machine Scale Set %q / Resource Group %q) still exists", name, scaleSetName, resourceGroup)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(*ArmClient).compute.VMScaleSetExtensionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseAzureResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resourceGroup := id.ResourceGroup
+		scaleSetName := id.Path["virtualMachineScaleSets"]
+		name := id.Path["extensions"]
+
+		resp, err := client.Get(ctx, resourceGroup, scaleSetName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Extension %q (Virtual Machine Scale Set %q / Resource Group %q) does not exist", name, scaleSetName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on VMScaleSetExtensionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineScaleSetExtension_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                           = "acctestvmssext-%d"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  virtual_machine_scale_set_name = "${azurerm_virtual_machine_scale_set.test.name}"
+  publisher                      = "Microsoft.Azure.Extensions"
+  type                           = "CustomScript"
+  type_handler_version           = "2.0"
+
+  settings = <<SETTINGS
+{
+  "commandToExecute": "hostname"
+}
+SETTINGS
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_requiresImport(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineScaleSetExtension_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "import" {
+  name                           = "${azurerm_virtual_machine_scale_set_extension.test.name}"
+  resource_group_name            = "${azurerm_virtual_machine_scale_set_extension.test.resource_group_name}"
+  virtual_machine_scale_set_name = "${azurerm_virtual_machine_scale_set_extension.test.virtual_machine_scale_set_name}"
+  publisher                      = "${azurerm_virtual_machine_scale_set_extension.test.publisher}"
+  type                           = "${azurerm_virtual_machine_scale_set_extension.test.type}"
+  type_handler_version           = "${azurerm_virtual_machine_scale_set_extension.test.type_handler_version}"
+  settings                       = "${azurerm_virtual_machine_scale_set_extension.test.settings}"
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_updated(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineScaleSetExtension_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                           = "acctestvmssext-%d"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  virtual_machine_scale_set_name = "${azurerm_virtual_machine_scale_set.test.name}"
+  publisher                      = "Microsoft.Azure.Extensions"
+  type                           = "CustomScript"
+  type_handler_version           = "2.0"
+  auto_upgrade_minor_version     = false
+  force_update_tag               = "second"
+
+  settings = <<SETTINGS
+{
+  "commandToExecute": "whoami"
+}
+SETTINGS
+
+  protected_settings = <<SETTINGS
+{
+  "fileUris": []
+}
+SETTINGS
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "accsa%[1]d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  tags = {
+    environment = "staging"
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode = "Manual"
+  zones               = []
+
+  sku {
+    name     = "Standard_D1_v2"
+    tier     = "Standard"
+    capacity = 2
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile-%[1]d"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      primary   = true
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  storage_profile_os_disk {
+    name           = "osDiskProfile"
+    caching        = "ReadWrite"
+    create_option  = "FromImage"
+    vhd_containers = ["${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"]
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  # extensions are managed through the azurerm_virtual_machine_scale_set_extension resource
+  lifecycle {
+    ignore_changes = ["extension"]
+  }
+}
+`, rInt, location)
+}